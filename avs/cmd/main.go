@@ -3,11 +3,22 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"flag"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/Layr-Labs/hourglass-monorepo/ponos/pkg/performer/server"
 	performerV1 "github.com/Layr-Labs/protocol-apis/gen/protos/eigenlayer/hourglass/v1/performer"
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/auction"
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/bidsig"
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/codec"
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/concurrency"
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/oracle"
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/store"
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/validation"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"go.uber.org/zap"
 )
 
@@ -15,7 +26,7 @@ import (
 type TaskType string
 
 const (
-	TaskTypeShieldMonitoring    TaskType = "shield_monitoring"
+	TaskTypeShieldMonitoring TaskType = "shield_monitoring"
 	TaskTypeAuctionCreation  TaskType = "auction_creation"
 	TaskTypeBidValidation    TaskType = "bid_validation"
 	TaskTypeSettlement       TaskType = "settlement"
@@ -25,6 +36,11 @@ const (
 type TaskPayload struct {
 	Type       TaskType               `json:"type"`
 	Parameters map[string]interface{} `json:"parameters"`
+
+	// ResponseFormat selects how HandleTask encodes its result: "json"
+	// (the default, used when empty) or "rlp" for a Solidity-decodable
+	// encoding. See the codec package.
+	ResponseFormat string `json:"response_format"`
 }
 
 // parseTaskPayload extracts and parses the task payload from TaskRequest
@@ -46,14 +62,39 @@ func parseTaskPayload(t *performerV1.TaskRequest) (*TaskPayload, error) {
 // Aggregator to place in the outbox once the signing threshold is met.
 type ShieldAuctionPerformer struct {
 	logger *zap.Logger
+
+	// bidNonces tracks consumed (auctionId, bidder, nonce) tuples to reject
+	// replayed EIP-712 bid signatures across handleBidValidation calls.
+	bidNonces *bidsig.NonceCache
+
+	// store persists auction lifecycle state so it survives performer
+	// restarts and re-org replays, and so settlement is idempotent.
+	store store.AuctionStore
+
+	// auctionLocks serializes handlers that mutate the same auction_id
+	// (creation, bid recording, settlement) while letting unrelated
+	// auctions be processed concurrently.
+	auctionLocks *concurrency.KeyedMutex
+
+	// taskTimeout bounds how long a single HandleTask call may run,
+	// mirroring the Hourglass executor's own request timeout so a
+	// handler stuck waiting on an auction lock can't block shutdown
+	// past it.
+	taskTimeout time.Duration
 }
 
-func NewShieldAuctionPerformer(logger *zap.Logger) *ShieldAuctionPerformer {
+// NewShieldAuctionPerformer builds a performer backed by auctionStore for
+// auction lifecycle persistence. Pass store.NewMemoryStore() for local
+// development or a store.NewBoltStore(path) for a durable deployment.
+func NewShieldAuctionPerformer(logger *zap.Logger, auctionStore store.AuctionStore) *ShieldAuctionPerformer {
 	return &ShieldAuctionPerformer{
-		logger: logger,
+		logger:       logger,
+		bidNonces:    bidsig.NewNonceCache(100_000),
+		store:        auctionStore,
+		auctionLocks: concurrency.New(),
+		taskTimeout:  5 * time.Second,
 	}
 }
-}
 
 func (lap *ShieldAuctionPerformer) ValidateTask(t *performerV1.TaskRequest) error {
 	lap.logger.Sugar().Infow("Validating Shield auction task",
@@ -64,7 +105,7 @@ func (lap *ShieldAuctionPerformer) ValidateTask(t *performerV1.TaskRequest) erro
 	// Shield Auction Task Validation Logic
 	// ------------------------------------------------------------------------
 	// Validate that the task request data is well-formed for Shield auction operations
-	
+
 	if len(t.TaskId) == 0 {
 		return fmt.Errorf("task ID cannot be empty")
 	}
@@ -73,11 +114,31 @@ func (lap *ShieldAuctionPerformer) ValidateTask(t *performerV1.TaskRequest) erro
 		return fmt.Errorf("task payload cannot be empty")
 	}
 
-	// TODO: Add specific validation based on task type:
-	// - Price monitoring task validation
-	// - Auction creation task validation  
-	// - Bid validation task validation
-	// - Settlement task validation
+	payload, err := parseTaskPayload(t)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(payload.Parameters)
+	if err != nil {
+		return fmt.Errorf("failed to re-marshal parameters for validation: %w", err)
+	}
+
+	switch payload.Type {
+	case TaskTypeShieldMonitoring:
+		_, err = validation.ParseShieldMonitoringParams(raw)
+	case TaskTypeAuctionCreation:
+		_, err = validation.ParseAuctionCreationParams(raw)
+	case TaskTypeBidValidation:
+		_, err = validation.ParseBidValidationParams(raw)
+	case TaskTypeSettlement:
+		_, err = validation.ParseSettlementParams(raw)
+	default:
+		return fmt.Errorf("unknown task type '%s' for task %s", payload.Type, string(t.TaskId))
+	}
+	if err != nil {
+		return fmt.Errorf("task %s failed schema validation: %w", string(t.TaskId), err)
+	}
 
 	lap.logger.Sugar().Infow("Task validation successful", "taskId", string(t.TaskId))
 	return nil
@@ -92,7 +153,7 @@ func (lap *ShieldAuctionPerformer) HandleTask(t *performerV1.TaskRequest) (*perf
 	// Shield Auction Task Processing Logic
 	// ------------------------------------------------------------------------
 	// This is where the Performer will execute Shield auction-specific work
-	
+
 	var resultBytes []byte
 	var err error
 
@@ -101,30 +162,36 @@ func (lap *ShieldAuctionPerformer) HandleTask(t *performerV1.TaskRequest) (*perf
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse task payload: %w", err)
 	}
-	
+
+	// Bound the whole handler by the same timeout the executor uses for
+	// this call, so a handler blocked on an auction lock (or a slow RPC)
+	// can't hold the server open past its own shutdown deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), lap.taskTimeout)
+	defer cancel()
+
 	// Route to appropriate handler based on task type
 	switch payload.Type {
 	case TaskTypeShieldMonitoring:
-		resultBytes, err = lap.handleShieldMonitoring(t, payload)
+		resultBytes, err = lap.handleShieldMonitoring(ctx, t, payload)
 	case TaskTypeAuctionCreation:
-		resultBytes, err = lap.handleAuctionCreation(t, payload)
+		resultBytes, err = lap.handleAuctionCreation(ctx, t, payload)
 	case TaskTypeBidValidation:
-		resultBytes, err = lap.handleBidValidation(t, payload)
+		resultBytes, err = lap.handleBidValidation(ctx, t, payload)
 	case TaskTypeSettlement:
-		resultBytes, err = lap.handleSettlement(t, payload)
+		resultBytes, err = lap.handleSettlement(ctx, t, payload)
 	default:
 		return nil, fmt.Errorf("unknown task type '%s' for task %s", payload.Type, string(t.TaskId))
 	}
 
 	if err != nil {
-		lap.logger.Sugar().Errorw("Task processing failed", 
-			"taskId", string(t.TaskId), 
+		lap.logger.Sugar().Errorw("Task processing failed",
+			"taskId", string(t.TaskId),
 			"error", err,
 		)
 		return nil, err
 	}
 
-	lap.logger.Sugar().Infow("Task processing completed successfully", 
+	lap.logger.Sugar().Infow("Task processing completed successfully",
 		"taskId", string(t.TaskId),
 		"resultSize", len(resultBytes),
 	)
@@ -135,65 +202,420 @@ func (lap *ShieldAuctionPerformer) HandleTask(t *performerV1.TaskRequest) (*perf
 	}, nil
 }
 
-// handleShieldMonitoring processes Shield monitoring tasks
-func (lap *ShieldAuctionPerformer) handleShieldMonitoring(t *performerV1.TaskRequest, payload *TaskPayload) ([]byte, error) {
+// shieldMonitoringPayload is the wire shape of a shield_monitoring task's
+// parameters.
+type shieldMonitoringPayload struct {
+	PoolAddress      string   `json:"pool_address"`
+	ChainlinkFeed    string   `json:"chainlink_feed"`
+	RPCEndpoints     []string `json:"rpc_endpoints"`
+	ThresholdBps     int64    `json:"threshold"`
+	StalenessSeconds int64    `json:"staleness_seconds"`
+}
+
+// handleShieldMonitoring processes Shield monitoring tasks: it pulls the
+// current Uniswap pool price and the Chainlink reference price, and reports
+// whether their deviation meets or exceeds the configured Shield threshold.
+func (lap *ShieldAuctionPerformer) handleShieldMonitoring(ctx context.Context, t *performerV1.TaskRequest, payload *TaskPayload) ([]byte, error) {
 	lap.logger.Sugar().Infow("Processing Shield monitoring task", "taskId", string(t.TaskId))
-	
-	// TODO: Implement Shield monitoring logic
-	// Example parameter access:
-	// poolAddress := payload.Parameters["pool_address"].(string)
-	// threshold := payload.Parameters["threshold"].(float64)
-	
-	// - Monitor price differences between pool and oracle
-	// - Check if Shield threshold is exceeded
-	// - Return monitoring result
-	
-	return []byte("Shield monitoring completed"), nil
-}
-
-// handleAuctionCreation processes auction creation tasks
-func (lap *ShieldAuctionPerformer) handleAuctionCreation(t *performerV1.TaskRequest, payload *TaskPayload) ([]byte, error) {
+
+	raw, err := json.Marshal(payload.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-marshal shield_monitoring parameters: %w", err)
+	}
+
+	var req shieldMonitoringPayload
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return nil, fmt.Errorf("failed to parse shield_monitoring parameters: %w", err)
+	}
+
+	if len(req.RPCEndpoints) == 0 {
+		return nil, fmt.Errorf("shield_monitoring requires at least one rpc_endpoints entry")
+	}
+
+	monitor, err := oracle.NewMonitor(oracle.Config{
+		RPCEndpoints:       req.RPCEndpoints,
+		PoolAddress:        common.HexToAddress(req.PoolAddress),
+		ChainlinkFeed:      common.HexToAddress(req.ChainlinkFeed),
+		StalenessThreshold: time.Duration(req.StalenessSeconds) * time.Second,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure oracle monitor: %w", err)
+	}
+
+	report, err := monitor.Evaluate(ctx, req.ThresholdBps)
+	if err != nil {
+		return nil, fmt.Errorf("shield monitoring evaluation failed: %w", err)
+	}
+
+	lap.logger.Sugar().Infow("Shield monitoring result",
+		"taskId", string(t.TaskId),
+		"deviationBps", report.DeviationBps,
+		"shouldTriggerAuction", report.ShouldTriggerAuction,
+	)
+
+	rlpResult := codec.MonitoringResult{
+		PoolAddress:  report.PoolAddress,
+		PoolPrice:    report.PoolPrice,
+		OraclePrice:  report.OraclePrice,
+		DeviationBps: uint64(report.DeviationBps),
+		BlockNumber:  report.BlockNumber,
+	}
+	return codec.Encode(payload.ResponseFormat, report, rlpResult)
+}
+
+// auctionCreationPayload is the wire shape of an auction_creation task's
+// parameters.
+type auctionCreationPayload struct {
+	AuctionID string `json:"auction_id"`
+	PoolID    string `json:"pool_id"`
+	Duration  int64  `json:"duration"`
+	MinBid    string `json:"min_bid"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// auctionCreationRLPResult projects an AuctionRecord into codec's
+// RLP-encodable shape. The auction ID is converted to a fixed 32 bytes with
+// common.HexToHash (hex-decoded and left-padded, not hashed) since
+// IDs are treated as hex identifiers elsewhere in this package (see bid and
+// settlement parsing), but are not guaranteed to be exactly 32 bytes.
+func auctionCreationRLPResult(record *store.AuctionRecord) codec.AuctionCreationResult {
+	return codec.AuctionCreationResult{
+		AuctionId: common.HexToHash(record.ID),
+		PoolId:    record.PoolID,
+		MinBid:    record.MinBid,
+		CreatedAt: uint64(record.CreatedAt.Unix()),
+	}
+}
+
+// handleAuctionCreation processes auction creation tasks. Creation is
+// idempotent: replaying the same auction_id returns the existing record
+// instead of erroring, so retried tasks converge across operators.
+func (lap *ShieldAuctionPerformer) handleAuctionCreation(ctx context.Context, t *performerV1.TaskRequest, payload *TaskPayload) ([]byte, error) {
 	lap.logger.Sugar().Infow("Processing auction creation task", "taskId", string(t.TaskId))
-	
-	// TODO: Implement auction creation logic
-	// - Create new auction when Shield threshold exceeded
-	// - Set auction parameters
-	// - Return auction creation result
-	
-	return []byte("Auction created"), nil
+
+	raw, err := json.Marshal(payload.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-marshal auction_creation parameters: %w", err)
+	}
+
+	var req auctionCreationPayload
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return nil, fmt.Errorf("failed to parse auction_creation parameters: %w", err)
+	}
+
+	if req.AuctionID == "" {
+		return nil, fmt.Errorf("auction_creation requires a non-empty auction_id")
+	}
+
+	minBid := new(big.Int)
+	if req.MinBid != "" {
+		if _, ok := minBid.SetString(req.MinBid, 0); !ok {
+			return nil, fmt.Errorf("invalid min_bid %q", req.MinBid)
+		}
+	}
+
+	var resultBytes []byte
+	err = lap.auctionLocks.Do(ctx, req.AuctionID, func() error {
+		record := &store.AuctionRecord{
+			ID:        req.AuctionID,
+			PoolID:    req.PoolID,
+			Duration:  time.Duration(req.Duration) * time.Second,
+			MinBid:    minBid,
+			CreatedAt: time.Unix(req.CreatedAt, 0).UTC(),
+			Status:    store.AuctionStatusOpen,
+		}
+
+		if err := lap.store.CreateAuction(ctx, record); err != nil {
+			existing, getErr := lap.store.GetAuction(ctx, req.AuctionID)
+			if getErr != nil {
+				return fmt.Errorf("failed to create auction %s: %w", req.AuctionID, err)
+			}
+			lap.logger.Sugar().Infow("Auction already exists, returning existing record", "auctionId", req.AuctionID)
+			var encodeErr error
+			resultBytes, encodeErr = codec.Encode(payload.ResponseFormat, existing, auctionCreationRLPResult(existing))
+			return encodeErr
+		}
+
+		var encodeErr error
+		resultBytes, encodeErr = codec.Encode(payload.ResponseFormat, record, auctionCreationRLPResult(record))
+		return encodeErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resultBytes, nil
+}
+
+// eip712BidPayload is the wire shape of a bid_validation task's parameters:
+// an EIP-712 domain separator, the signed Bid struct, and its signature.
+type eip712BidPayload struct {
+	Domain struct {
+		Name              string `json:"name"`
+		Version           string `json:"version"`
+		ChainId           string `json:"chainId"`
+		VerifyingContract string `json:"verifyingContract"`
+	} `json:"domain"`
+	Bid struct {
+		AuctionId string `json:"auctionId"`
+		Bidder    string `json:"bidder"`
+		Amount    string `json:"amount"`
+		Nonce     string `json:"nonce"`
+		Deadline  string `json:"deadline"`
+	} `json:"bid"`
+	Signature string `json:"signature"`
 }
 
-// handleBidValidation processes bid validation tasks
-func (lap *ShieldAuctionPerformer) handleBidValidation(t *performerV1.TaskRequest, payload *TaskPayload) ([]byte, error) {
+// handleBidValidation processes bid validation tasks. Bids are submitted as
+// EIP-712 typed data: the performer recomputes the digest, recovers the
+// signer via secp256k1 ecrecover, and checks it against the claimed bidder,
+// the signature deadline, and a replay-protection nonce cache.
+func (lap *ShieldAuctionPerformer) handleBidValidation(ctx context.Context, t *performerV1.TaskRequest, payload *TaskPayload) ([]byte, error) {
 	lap.logger.Sugar().Infow("Processing bid validation task", "taskId", string(t.TaskId))
-	
-	// TODO: Implement bid validation logic
-	// - Validate bid parameters
-	// - Check bid amount and authorization
-	// - Return validation result
-	
-	return []byte("Bid validated"), nil
+
+	raw, err := json.Marshal(payload.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-marshal bid_validation parameters: %w", err)
+	}
+
+	var req eip712BidPayload
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return nil, fmt.Errorf("failed to parse bid_validation parameters: %w", err)
+	}
+
+	chainID, ok := new(big.Int).SetString(req.Domain.ChainId, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid chainId %q", req.Domain.ChainId)
+	}
+
+	domain := bidsig.Domain{
+		Name:              req.Domain.Name,
+		Version:           req.Domain.Version,
+		ChainID:           chainID,
+		VerifyingContract: common.HexToAddress(req.Domain.VerifyingContract),
+	}
+
+	amount, ok := new(big.Int).SetString(req.Bid.Amount, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid bid amount %q", req.Bid.Amount)
+	}
+	nonce, ok := new(big.Int).SetString(req.Bid.Nonce, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid bid nonce %q", req.Bid.Nonce)
+	}
+	deadline, ok := new(big.Int).SetString(req.Bid.Deadline, 0)
+	if !ok {
+		return nil, fmt.Errorf("invalid bid deadline %q", req.Bid.Deadline)
+	}
+
+	bid := bidsig.Bid{
+		AuctionID: common.HexToHash(req.Bid.AuctionId),
+		Bidder:    common.HexToAddress(req.Bid.Bidder),
+		Amount:    amount,
+		Nonce:     nonce,
+		Deadline:  deadline,
+	}
+
+	sig, err := hexutil.Decode(req.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature hex: %w", err)
+	}
+
+	result, err := bidsig.Verify(domain, bid, sig, bid.Bidder, time.Now(), lap.bidNonces)
+	if err != nil {
+		return nil, fmt.Errorf("bid verification failed: %w", err)
+	}
+
+	lap.logger.Sugar().Infow("Bid validation result",
+		"taskId", string(t.TaskId),
+		"signer", result.Signer.Hex(),
+		"valid", result.Valid,
+	)
+
+	if result.Valid {
+		bidRecord := store.BidRecord{
+			Bidder:     bid.Bidder.Hex(),
+			Amount:     bid.Amount,
+			Nonce:      bid.Nonce.String(),
+			RecordedAt: time.Now(),
+		}
+		err := lap.auctionLocks.Do(ctx, req.Bid.AuctionId, func() error {
+			return lap.store.RecordBid(ctx, req.Bid.AuctionId, bidRecord)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to record bid for auction %s: %w", req.Bid.AuctionId, err)
+		}
+	}
+
+	rlpResult := codec.BidValidationResult{
+		Signer: result.Signer,
+		Digest: result.Digest,
+		Valid:  result.Valid,
+	}
+	return codec.Encode(payload.ResponseFormat, result, rlpResult)
+}
+
+// settlementPayload is the wire shape of a settlement task's parameters: the
+// commitments recorded during the auction's commit phase, and the bids
+// revealed for settlement.
+type settlementPayload struct {
+	AuctionID   string `json:"auction_id"`
+	Commitments []struct {
+		Bidder     string `json:"bidder"`
+		Commitment string `json:"commitment"`
+	} `json:"commitments"`
+	Reveals []struct {
+		Bidder string `json:"bidder"`
+		Amount string `json:"amount"`
+		Salt   string `json:"salt"`
+	} `json:"reveals"`
+}
+
+// settlementJSONResult extends SettlementResult with each revealed bidder's
+// Merkle inclusion proof, keyed by bidder hex address, so a bidder can pull
+// their own compact proof of inclusion straight out of the settlement
+// response instead of needing every other revealed bid to recompute the root.
+type settlementJSONResult struct {
+	*auction.SettlementResult
+	BidProofs map[string]auction.Proof `json:"bid_proofs"`
 }
 
-// handleSettlement processes settlement tasks
-func (lap *ShieldAuctionPerformer) handleSettlement(t *performerV1.TaskRequest, payload *TaskPayload) ([]byte, error) {
+// handleSettlement processes settlement tasks as a sealed-bid, second-price
+// (Vickrey) auction: it checks each revealed bid against its commitment,
+// then deterministically selects a winner and clearing price so every
+// operator computes byte-identical settlement bytes. Settlement is also
+// idempotent: settling the same auction twice returns the result bytes
+// stored the first time, so aggregator signature threshold logic converges
+// across operators.
+func (lap *ShieldAuctionPerformer) handleSettlement(ctx context.Context, t *performerV1.TaskRequest, payload *TaskPayload) ([]byte, error) {
 	lap.logger.Sugar().Infow("Processing settlement task", "taskId", string(t.TaskId))
-	
-	// TODO: Implement settlement logic
-	// - Finalize auction results
-	// - Distribute MEV rewards
-	// - Return settlement result
-	
-	return []byte("Settlement completed"), nil
+
+	raw, err := json.Marshal(payload.Parameters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-marshal settlement parameters: %w", err)
+	}
+
+	var req settlementPayload
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return nil, fmt.Errorf("failed to parse settlement parameters: %w", err)
+	}
+
+	if req.AuctionID == "" {
+		return nil, fmt.Errorf("settlement requires a non-empty auction_id")
+	}
+
+	commitments := make(map[common.Address]common.Hash, len(req.Commitments))
+	for _, c := range req.Commitments {
+		commitments[common.HexToAddress(c.Bidder)] = common.HexToHash(c.Commitment)
+	}
+
+	reveals := make([]auction.RevealedBid, 0, len(req.Reveals))
+	for _, r := range req.Reveals {
+		amount, ok := new(big.Int).SetString(r.Amount, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid revealed bid amount %q", r.Amount)
+		}
+		saltBytes, err := hexutil.Decode(r.Salt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid revealed bid salt %q: %w", r.Salt, err)
+		}
+		var salt [32]byte
+		copy(salt[32-len(saltBytes):], saltBytes)
+
+		reveals = append(reveals, auction.RevealedBid{
+			Bidder: common.HexToAddress(r.Bidder),
+			Amount: amount,
+			Salt:   salt,
+		})
+	}
+
+	var resultBytes []byte
+	err = lap.auctionLocks.Do(ctx, req.AuctionID, func() error {
+		auctionRecord, err := lap.store.GetAuction(ctx, req.AuctionID)
+		if err != nil {
+			return fmt.Errorf("failed to load auction %s for settlement: %w", req.AuctionID, err)
+		}
+
+		settlement, bidProofs, err := auction.Settle(auctionRecord.ID, commitments, reveals)
+		if err != nil {
+			return fmt.Errorf("failed to settle auction %s: %w", req.AuctionID, err)
+		}
+
+		proofsByBidder := make(map[string]auction.Proof, len(bidProofs))
+		for _, bp := range bidProofs {
+			proofsByBidder[bp.Bidder.Hex()] = bp.Proof
+		}
+
+		rlpResult := codec.SettlementResult{
+			AuctionId:     common.HexToHash(settlement.AuctionID),
+			Winner:        settlement.Winner,
+			Amount:        settlement.WinningBidAmount,
+			ClearingPrice: settlement.ClearingPrice,
+		}
+		result, err := codec.Encode(payload.ResponseFormat, settlementJSONResult{settlement, proofsByBidder}, rlpResult)
+		if err != nil {
+			return fmt.Errorf("failed to encode settlement result for auction %s: %w", req.AuctionID, err)
+		}
+
+		stored, _, alreadySettled, err := lap.store.SettleAuction(ctx, req.AuctionID, result, proofsByBidder)
+		if err != nil {
+			return fmt.Errorf("failed to settle auction %s: %w", req.AuctionID, err)
+		}
+
+		lap.logger.Sugar().Infow("Settlement result",
+			"taskId", string(t.TaskId),
+			"auctionId", req.AuctionID,
+			"alreadySettled", alreadySettled,
+		)
+
+		resultBytes = stored
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resultBytes, nil
 }
 
 // Task type detection functions are no longer needed as we parse the payload directly
 
+// newAuctionStore builds the AuctionStore selected by driver. "bolt" (the
+// default) is durable across restarts, backed by the BoltDB file at
+// dbPath; "memory" is for local development only and loses all auction
+// state on exit. The returned close func releases any held resources and
+// is always safe to call.
+func newAuctionStore(driver, dbPath string, logger *zap.Logger) (store.AuctionStore, func(), error) {
+	switch driver {
+	case "", "bolt":
+		boltStore, err := store.NewBoltStore(dbPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		return boltStore, func() { _ = boltStore.Close() }, nil
+	case "memory":
+		logger.Sugar().Warnw("Using in-memory auction store; auction state will not survive a restart", "store", driver)
+		return store.NewMemoryStore(), func() {}, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown -store %q: expected \"bolt\" or \"memory\"", driver)
+	}
+}
+
 func main() {
 	ctx := context.Background()
 	l, _ := zap.NewProduction()
 
-	performer := NewShieldAuctionPerformer(l)
+	dbPath := flag.String("db-path", "shield-auction.db", "path to the BoltDB file backing durable auction state; ignored when -store=memory")
+	storeDriver := flag.String("store", "bolt", `auction store backend: "bolt" (durable, default) or "memory" (local development only; auction state does not survive a restart)`)
+	flag.Parse()
+
+	auctionStore, closeStore, err := newAuctionStore(*storeDriver, *dbPath, l)
+	if err != nil {
+		panic(fmt.Errorf("failed to open auction store: %w", err))
+	}
+	defer closeStore()
+
+	performer := NewShieldAuctionPerformer(l, auctionStore)
 
 	pp, err := server.NewPonosPerformerWithRpcServer(&server.PonosPerformerConfig{
 		Port:    8080,
@@ -207,4 +629,4 @@ func main() {
 	if err := pp.Start(ctx); err != nil {
 		panic(err)
 	}
-}
\ No newline at end of file
+}
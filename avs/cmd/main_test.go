@@ -1,10 +1,22 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"math/big"
+	"sync"
 	"testing"
+	"time"
 
 	performerV1 "github.com/Layr-Labs/protocol-apis/gen/protos/eigenlayer/hourglass/v1/performer"
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/auction"
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/bidsig"
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/codec"
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/store"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"go.uber.org/zap"
 )
 
@@ -18,25 +30,108 @@ func Test_ShieldAuctionTaskRequestPayload(t *testing.T) {
 		t.Errorf("Failed to create logger: %v", err)
 	}
 
-	performer := NewShieldAuctionPerformer(logger)
+	performer := NewShieldAuctionPerformer(logger, store.NewMemoryStore())
 
-	// Test basic task validation
+	// A non-JSON payload cannot satisfy any task type's schema, so both
+	// validation and handling are expected to fail.
 	taskRequest := &performerV1.TaskRequest{
 		TaskId:  []byte("test-shield-task-id"),
 		Payload: []byte("test-data"),
 	}
 
 	err = performer.ValidateTask(taskRequest)
-	if err != nil {
-		t.Errorf("ValidateTask failed: %v", err)
+	if err == nil {
+		t.Errorf("ValidateTask expected an error for a non-JSON payload")
 	}
 
 	resp, err := performer.HandleTask(taskRequest)
+	if err == nil {
+		t.Errorf("HandleTask expected an error for a non-JSON payload, got response: %v", resp)
+	}
+}
+
+// signTestBid builds a fully signed EIP-712 bid_validation parameter set
+// using a freshly generated key, so the generic task-type loop below can
+// exercise the real signature-verification path.
+func signTestBid(t *testing.T, auctionID common.Hash, amount, nonce, deadline *big.Int) (map[string]interface{}, common.Address) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
 	if err != nil {
-		t.Errorf("HandleTask failed: %v", err)
+		t.Fatalf("failed to generate test key: %v", err)
+	}
+	bidder := crypto.PubkeyToAddress(key.PublicKey)
+
+	domain := bidsig.Domain{
+		Name:              "ShieldAuction",
+		Version:           "1",
+		ChainID:           big.NewInt(1),
+		VerifyingContract: common.HexToAddress("0x000000000000000000000000000000000000aa01"),
+	}
+	bid := bidsig.Bid{
+		AuctionID: auctionID,
+		Bidder:    bidder,
+		Amount:    amount,
+		Nonce:     nonce,
+		Deadline:  deadline,
+	}
+
+	digest := bidsig.Digest(domain, bid)
+	sig, err := crypto.Sign(digest.Bytes(), key)
+	if err != nil {
+		t.Fatalf("failed to sign test bid digest: %v", err)
+	}
+	sig[64] += 27
+
+	params := map[string]interface{}{
+		"domain": map[string]interface{}{
+			"name":              domain.Name,
+			"version":           domain.Version,
+			"chainId":           domain.ChainID.String(),
+			"verifyingContract": domain.VerifyingContract.Hex(),
+		},
+		"bid": map[string]interface{}{
+			"auctionId": auctionID.Hex(),
+			"bidder":    bidder.Hex(),
+			"amount":    amount.String(),
+			"nonce":     nonce.String(),
+			"deadline":  deadline.String(),
+		},
+		"signature": hexutil.Encode(sig),
+	}
+	return params, bidder
+}
+
+// sealedBidSettlementParams builds a settlement task's commit-reveal
+// parameters for two bids, so the generic task-type loop exercises the
+// second-price settlement path end to end.
+func sealedBidSettlementParams(auctionID string) map[string]interface{} {
+	bidders := []common.Address{
+		common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		common.HexToAddress("0x2222222222222222222222222222222222222222"),
+	}
+	amounts := []*big.Int{big.NewInt(500), big.NewInt(300)}
+	salts := [][32]byte{{0x01}, {0x02}}
+
+	commitments := make([]map[string]interface{}, len(bidders))
+	reveals := make([]map[string]interface{}, len(bidders))
+	for i, bidder := range bidders {
+		commitments[i] = map[string]interface{}{
+			"bidder":     bidder.Hex(),
+			"commitment": auction.Commitment(amounts[i], salts[i], bidder).Hex(),
+		}
+		reveals[i] = map[string]interface{}{
+			"bidder": bidder.Hex(),
+			"amount": amounts[i].String(),
+			"salt":   hexutil.Encode(salts[i][:]),
+		}
 	}
 
-	t.Logf("Response: %v", resp)
+	return map[string]interface{}{
+		"auction_id":  auctionID,
+		"commitments": commitments,
+		"reveals":     reveals,
+	}
 }
 
 func Test_ShieldAuctionTaskTypes(t *testing.T) {
@@ -45,47 +140,38 @@ func Test_ShieldAuctionTaskTypes(t *testing.T) {
 		t.Errorf("Failed to create logger: %v", err)
 	}
 
-	performer := NewShieldAuctionPerformer(logger)
+	performer := NewShieldAuctionPerformer(logger, store.NewMemoryStore())
+
+	auctionID := common.HexToHash("0x123")
+	bidParams, _ := signTestBid(t, auctionID, big.NewInt(500), big.NewInt(1), big.NewInt(time.Now().Add(time.Hour).Unix()))
 
+	// Shield Monitoring Task is covered by oracle's own unit tests: it now
+	// dials real JSON-RPC endpoints, which this suite does not stand up.
 	testCases := []struct {
 		name     string
 		taskType TaskType
 		params   map[string]interface{}
 	}{
-		{
-			name:     "Shield Monitoring Task",
-			taskType: TaskTypeShieldMonitoring,
-			params: map[string]interface{}{
-				"pool_address": "0x1234567890abcdef",
-				"threshold":    1000,
-			},
-		},
 		{
 			name:     "Auction Creation Task",
 			taskType: TaskTypeAuctionCreation,
 			params: map[string]interface{}{
-				"pool_id":     "0xabcdef",
-				"duration":    3600,
-				"min_bid":     100,
+				"auction_id": auctionID.Hex(),
+				"pool_id":    "0xabcdef",
+				"duration":   3600,
+				"min_bid":    "100",
+				"created_at": 1700000000,
 			},
 		},
 		{
 			name:     "Bid Validation Task",
 			taskType: TaskTypeBidValidation,
-			params: map[string]interface{}{
-				"auction_id": "0x123",
-				"bid_amount": 500,
-				"bidder":     "0xbidder",
-			},
+			params:   bidParams,
 		},
 		{
 			name:     "Settlement Task",
 			taskType: TaskTypeSettlement,
-			params: map[string]interface{}{
-				"auction_id": "0x123",
-				"winner":     "0xwinner",
-				"amount":     1000,
-			},
+			params:   sealedBidSettlementParams(auctionID.Hex()),
 		},
 	}
 
@@ -137,6 +223,81 @@ func Test_ShieldAuctionTaskTypes(t *testing.T) {
 	}
 }
 
+// TestConcurrentBidValidation fires N goroutines with distinct, validly
+// signed bids at the same auction concurrently, proving handleBidValidation
+// serializes its RecordBid calls through the per-auction keyed mutex so no
+// bid is dropped under concurrent load. Run with `go test -race` to also
+// confirm there's no data race on the shared store or nonce cache.
+func TestConcurrentBidValidation(t *testing.T) {
+	logger, err := zap.NewDevelopment()
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	performer := NewShieldAuctionPerformer(logger, store.NewMemoryStore())
+
+	auctionID := common.HexToHash("0xc0ffee")
+	creationPayload, err := json.Marshal(TaskPayload{
+		Type: TaskTypeAuctionCreation,
+		Parameters: map[string]interface{}{
+			"auction_id": auctionID.Hex(),
+			"pool_id":    "0xabcdef",
+			"duration":   3600,
+			"min_bid":    "1",
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal auction_creation payload: %v", err)
+	}
+	if _, err := performer.HandleTask(&performerV1.TaskRequest{
+		TaskId:  []byte("concurrent-create"),
+		Payload: creationPayload,
+	}); err != nil {
+		t.Fatalf("failed to create auction: %v", err)
+	}
+
+	const numBidders = 25
+	var wg sync.WaitGroup
+	errs := make(chan error, numBidders)
+
+	for i := 0; i < numBidders; i++ {
+		bidParams, _ := signTestBid(t, auctionID, big.NewInt(int64(100+i)), big.NewInt(int64(i)), big.NewInt(time.Now().Add(time.Hour).Unix()))
+		payloadBytes, err := json.Marshal(TaskPayload{
+			Type:       TaskTypeBidValidation,
+			Parameters: bidParams,
+		})
+		if err != nil {
+			t.Fatalf("failed to marshal bid_validation payload: %v", err)
+		}
+
+		wg.Add(1)
+		go func(i int, payloadBytes []byte) {
+			defer wg.Done()
+			_, err := performer.HandleTask(&performerV1.TaskRequest{
+				TaskId:  []byte("concurrent-bid"),
+				Payload: payloadBytes,
+			})
+			errs <- err
+		}(i, payloadBytes)
+	}
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("concurrent bid validation failed: %v", err)
+		}
+	}
+
+	record, err := performer.store.GetAuction(context.Background(), auctionID.Hex())
+	if err != nil {
+		t.Fatalf("failed to load auction after concurrent bids: %v", err)
+	}
+	if len(record.Bids) != numBidders {
+		t.Errorf("expected %d recorded bids, got %d (lost bids under concurrency)", numBidders, len(record.Bids))
+	}
+}
+
 func Test_TaskPayloadParsing(t *testing.T) {
 	// Test payload parsing functionality
 	testPayload := TaskPayload{
@@ -173,4 +334,48 @@ func Test_TaskPayloadParsing(t *testing.T) {
 	}
 
 	t.Logf("Payload parsing test successful: %+v", parsedPayload)
-}
\ No newline at end of file
+}
+
+func Test_HandleTaskRLPResponseFormat(t *testing.T) {
+	logger, err := zap.NewDevelopment()
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	performer := NewShieldAuctionPerformer(logger, store.NewMemoryStore())
+
+	payload := TaskPayload{
+		Type: TaskTypeAuctionCreation,
+		Parameters: map[string]interface{}{
+			"auction_id": "0x456",
+			"pool_id":    "0xabcdef",
+			"duration":   3600,
+			"min_bid":    "100",
+		},
+		ResponseFormat: "rlp",
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Failed to marshal payload: %v", err)
+	}
+
+	resp, err := performer.HandleTask(&performerV1.TaskRequest{
+		TaskId:  []byte("rlp-format-test"),
+		Payload: payloadBytes,
+	})
+	if err != nil {
+		t.Fatalf("HandleTask failed: %v", err)
+	}
+
+	var decoded codec.AuctionCreationResult
+	if err := rlp.DecodeBytes(resp.Result, &decoded); err != nil {
+		t.Fatalf("failed to RLP-decode result: %v", err)
+	}
+	if decoded.PoolId != "0xabcdef" {
+		t.Errorf("expected pool_id 0xabcdef, got %s", decoded.PoolId)
+	}
+	if decoded.MinBid.Cmp(big.NewInt(100)) != 0 {
+		t.Errorf("expected min_bid 100, got %s", decoded.MinBid.String())
+	}
+}
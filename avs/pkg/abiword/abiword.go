@@ -0,0 +1,19 @@
+// Package abiword holds small helpers for building Solidity
+// abi.encode-compatible 32-byte words, shared by the packages that hash
+// values the same way a verifying contract would.
+package abiword
+
+// Pad32 left-pads b to 32 bytes, matching Solidity's abi.encode word size.
+// Callers are expected to only ever pass values already bounded to a
+// uint256 (validation.ParseBidValidationParams and
+// validation.ParseSettlementParams enforce this on every field that
+// reaches here); b longer than 32 bytes is truncated to its low-order 32
+// rather than panicking on the negative copy offset.
+func Pad32(b []byte) []byte {
+	if len(b) >= 32 {
+		return b[len(b)-32:]
+	}
+	out := make([]byte, 32)
+	copy(out[32-len(b):], b)
+	return out
+}
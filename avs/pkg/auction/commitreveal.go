@@ -0,0 +1,36 @@
+// Package auction implements deterministic sealed-bid (commit-reveal)
+// second-price auction settlement, so independent operators running the
+// same performer converge on identical settlement results for BLS
+// threshold signing.
+package auction
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/abiword"
+)
+
+// Commitment is a bidder's sealed bid: keccak256(bidAmount || salt || bidder).
+func Commitment(amount *big.Int, salt [32]byte, bidder common.Address) common.Hash {
+	buf := make([]byte, 0, 96)
+	buf = append(buf, abiword.Pad32(amount.Bytes())...)
+	buf = append(buf, salt[:]...)
+	buf = append(buf, abiword.Pad32(bidder.Bytes())...)
+	return crypto.Keccak256Hash(buf)
+}
+
+// RevealedBid is a bid revealed during the reveal phase.
+type RevealedBid struct {
+	Bidder common.Address
+	Amount *big.Int
+	Salt   [32]byte
+}
+
+// VerifyCommitment reports whether reveal matches the commitment recorded
+// during the commit phase.
+func VerifyCommitment(committed common.Hash, reveal RevealedBid) bool {
+	return Commitment(reveal.Amount, reveal.Salt, reveal.Bidder) == committed
+}
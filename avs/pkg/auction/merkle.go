@@ -0,0 +1,110 @@
+package auction
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/abiword"
+)
+
+// BidLeaf hashes a single (bidder, amount) outcome into a Merkle leaf.
+func BidLeaf(bidder common.Address, amount *big.Int) common.Hash {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, abiword.Pad32(bidder.Bytes())...)
+	buf = append(buf, abiword.Pad32(amount.Bytes())...)
+	return crypto.Keccak256Hash(buf)
+}
+
+// merkleTree is a binary Merkle tree built bottom-up over leaves, hashing
+// sibling pairs with keccak256(left || right). A dangling odd node is
+// promoted unchanged to the next level.
+type merkleTree struct {
+	levels [][]common.Hash
+}
+
+// buildMerkleTree builds a tree from leaves. leaves must already be in the
+// order the caller wants committed to the root (this package sorts bids
+// before calling it).
+func buildMerkleTree(leaves []common.Hash) *merkleTree {
+	if len(leaves) == 0 {
+		return &merkleTree{levels: [][]common.Hash{{common.Hash{}}}}
+	}
+
+	levels := [][]common.Hash{leaves}
+	current := leaves
+
+	for len(current) > 1 {
+		next := make([]common.Hash, 0, (len(current)+1)/2)
+		for i := 0; i < len(current); i += 2 {
+			if i+1 == len(current) {
+				next = append(next, current[i])
+				continue
+			}
+			buf := append(append([]byte{}, current[i].Bytes()...), current[i+1].Bytes()...)
+			next = append(next, crypto.Keccak256Hash(buf))
+		}
+		levels = append(levels, next)
+		current = next
+	}
+
+	return &merkleTree{levels: levels}
+}
+
+// Root returns the Merkle root.
+func (m *merkleTree) Root() common.Hash {
+	top := m.levels[len(m.levels)-1]
+	return top[0]
+}
+
+// Proof is an inclusion proof for the leaf at Index in the tree the proof
+// was generated from. Siblings has exactly one entry per level below the
+// root; a nil entry means that level's node was a dangling odd node
+// promoted unchanged (so the verifier must still descend a level without
+// hashing in a sibling).
+type Proof struct {
+	Leaf     common.Hash    `json:"leaf"`
+	Siblings []*common.Hash `json:"siblings"`
+	Index    int            `json:"index"`
+}
+
+// proof returns an inclusion proof for the leaf at index.
+func (m *merkleTree) proof(index int) Proof {
+	siblings := make([]*common.Hash, 0, len(m.levels)-1)
+	idx := index
+
+	for _, level := range m.levels[:len(m.levels)-1] {
+		siblingIdx := idx ^ 1
+		if siblingIdx < len(level) {
+			sibling := level[siblingIdx]
+			siblings = append(siblings, &sibling)
+		} else {
+			siblings = append(siblings, nil)
+		}
+		idx /= 2
+	}
+
+	return Proof{Leaf: m.levels[0][index], Siblings: siblings, Index: index}
+}
+
+// VerifyProof recomputes the root from p and reports whether it matches root.
+func VerifyProof(root common.Hash, p Proof) bool {
+	current := p.Leaf
+	idx := p.Index
+
+	for _, sibling := range p.Siblings {
+		if sibling != nil {
+			var buf []byte
+			if idx%2 == 0 {
+				buf = append(append([]byte{}, current.Bytes()...), sibling.Bytes()...)
+			} else {
+				buf = append(append([]byte{}, sibling.Bytes()...), current.Bytes()...)
+			}
+			current = crypto.Keccak256Hash(buf)
+		}
+		idx /= 2
+	}
+
+	return current == root
+}
@@ -0,0 +1,99 @@
+package auction
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/abiword"
+)
+
+// SettlementResult is the canonical, deterministic outcome of a sealed-bid
+// second-price (Vickrey) auction. Field order is fixed so json.Marshal
+// produces the same byte-for-byte output on every operator.
+type SettlementResult struct {
+	AuctionID        string         `json:"auction_id"`
+	Winner           common.Address `json:"winner"`
+	WinningBidAmount *big.Int       `json:"winning_bid_amount"`
+	ClearingPrice    *big.Int       `json:"clearing_price"`
+	RevealedBidCount int            `json:"revealed_bid_count"`
+	MerkleRootOfBids common.Hash    `json:"merkle_root_of_bids"`
+}
+
+// BidProof pairs a revealed bidder with their inclusion proof against the
+// settlement's MerkleRootOfBids, so a proof can be looked up or persisted
+// per bidder rather than only as an anonymous positional slice.
+type BidProof struct {
+	Bidder common.Address `json:"bidder"`
+	Proof  Proof          `json:"proof"`
+}
+
+// Settle validates reveals against their recorded commitments, then
+// deterministically selects a winner and clearing price: bids are sorted by
+// amount descending, tie-broken by keccak256(bidder) ascending, the top bid
+// wins, and the second-highest amount becomes the clearing price. It also
+// returns an inclusion proof for every valid bid, paired with its bidder, so
+// any bidder's outcome can be proven against MerkleRootOfBids.
+func Settle(auctionID string, commitments map[common.Address]common.Hash, reveals []RevealedBid) (*SettlementResult, []BidProof, error) {
+	valid := make([]RevealedBid, 0, len(reveals))
+	for _, reveal := range reveals {
+		committed, ok := commitments[reveal.Bidder]
+		if !ok {
+			continue
+		}
+		if VerifyCommitment(committed, reveal) {
+			valid = append(valid, reveal)
+		}
+	}
+
+	if len(valid) == 0 {
+		return nil, nil, fmt.Errorf("auction: no validly revealed bids for auction %s", auctionID)
+	}
+
+	sort.Slice(valid, func(i, j int) bool {
+		byAmount := valid[i].Amount.Cmp(valid[j].Amount)
+		if byAmount != 0 {
+			return byAmount > 0
+		}
+		hi, hj := keccakAddress(valid[i].Bidder), keccakAddress(valid[j].Bidder)
+		return bytes.Compare(hi.Bytes(), hj.Bytes()) < 0
+	})
+
+	winner := valid[0].Bidder
+	clearingPrice := new(big.Int).Set(valid[0].Amount)
+	if len(valid) > 1 {
+		clearingPrice = new(big.Int).Set(valid[1].Amount)
+	}
+
+	leaves := make([]common.Hash, len(valid))
+	for i, bid := range valid {
+		leaves[i] = BidLeaf(bid.Bidder, bid.Amount)
+	}
+
+	tree := buildMerkleTree(leaves)
+	proofs := make([]BidProof, len(valid))
+	for i := range valid {
+		proofs[i] = BidProof{Bidder: valid[i].Bidder, Proof: tree.proof(i)}
+	}
+
+	result := &SettlementResult{
+		AuctionID:        auctionID,
+		Winner:           winner,
+		WinningBidAmount: new(big.Int).Set(valid[0].Amount),
+		ClearingPrice:    clearingPrice,
+		RevealedBidCount: len(valid),
+		MerkleRootOfBids: tree.Root(),
+	}
+
+	return result, proofs, nil
+}
+
+// keccakAddress is a helper returning bidder as a comparable common.Hash for
+// the tiebreak sort; kept free-standing so sort.Slice's comparator stays short.
+func keccakAddress(bidder common.Address) common.Hash {
+	return crypto.Keccak256Hash(abiword.Pad32(bidder.Bytes()))
+}
@@ -0,0 +1,84 @@
+package auction
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func mustReveal(bidder string, amount int64, salt byte) RevealedBid {
+	var s [32]byte
+	s[0] = salt
+	return RevealedBid{
+		Bidder: common.HexToAddress(bidder),
+		Amount: big.NewInt(amount),
+		Salt:   s,
+	}
+}
+
+func TestSettleSecondPriceAndProofs(t *testing.T) {
+	reveals := []RevealedBid{
+		mustReveal("0x1111111111111111111111111111111111111111", 100, 1),
+		mustReveal("0x2222222222222222222222222222222222222222", 300, 2),
+		mustReveal("0x3333333333333333333333333333333333333333", 200, 3),
+	}
+
+	commitments := make(map[common.Address]common.Hash, len(reveals))
+	for _, r := range reveals {
+		commitments[r.Bidder] = Commitment(r.Amount, r.Salt, r.Bidder)
+	}
+
+	result, proofs, err := Settle("auction-1", commitments, reveals)
+	if err != nil {
+		t.Fatalf("Settle failed: %v", err)
+	}
+
+	if result.Winner != common.HexToAddress("0x2222222222222222222222222222222222222222") {
+		t.Errorf("expected winner 0x22...22, got %s", result.Winner.Hex())
+	}
+	if result.ClearingPrice.Cmp(big.NewInt(200)) != 0 {
+		t.Errorf("expected clearing price 200, got %s", result.ClearingPrice.String())
+	}
+	if result.RevealedBidCount != 3 {
+		t.Errorf("expected 3 revealed bids, got %d", result.RevealedBidCount)
+	}
+
+	for i, bp := range proofs {
+		if !VerifyProof(result.MerkleRootOfBids, bp.Proof) {
+			t.Errorf("proof %d failed to verify against merkle root", i)
+		}
+		if bp.Bidder == (common.Address{}) {
+			t.Errorf("proof %d missing bidder", i)
+		}
+	}
+}
+
+func TestSettleRejectsBadReveal(t *testing.T) {
+	honest := mustReveal("0x1111111111111111111111111111111111111111", 100, 1)
+	tampered := mustReveal("0x2222222222222222222222222222222222222222", 500, 2)
+
+	commitments := map[common.Address]common.Hash{
+		honest.Bidder:   Commitment(honest.Amount, honest.Salt, honest.Bidder),
+		tampered.Bidder: Commitment(big.NewInt(50), tampered.Salt, tampered.Bidder), // committed to a different amount
+	}
+
+	result, _, err := Settle("auction-2", commitments, []RevealedBid{honest, tampered})
+	if err != nil {
+		t.Fatalf("Settle failed: %v", err)
+	}
+
+	if result.RevealedBidCount != 1 {
+		t.Errorf("expected the tampered reveal to be rejected, got %d valid bids", result.RevealedBidCount)
+	}
+	if result.Winner != honest.Bidder {
+		t.Errorf("expected honest bidder to win, got %s", result.Winner.Hex())
+	}
+}
+
+func TestSettleNoValidReveals(t *testing.T) {
+	_, _, err := Settle("auction-3", map[common.Address]common.Hash{}, nil)
+	if err == nil {
+		t.Fatal("expected an error when no bids were validly revealed")
+	}
+}
@@ -0,0 +1,185 @@
+// Package bidsig implements EIP-712 typed-data signing and verification for
+// Shield auction bids, so bidders can sign a structured, wallet-displayable
+// message instead of an opaque string.
+package bidsig
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/abiword"
+)
+
+// domainTypeHash is keccak256("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)").
+var domainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+
+// bidTypeHash is keccak256("Bid(bytes32 auctionId,address bidder,uint256 amount,uint256 nonce,uint256 deadline)").
+var bidTypeHash = crypto.Keccak256Hash([]byte("Bid(bytes32 auctionId,address bidder,uint256 amount,uint256 nonce,uint256 deadline)"))
+
+// Domain carries the EIP-712 domain separator fields for a Shield auction deployment.
+type Domain struct {
+	Name              string
+	Version           string
+	ChainID           *big.Int
+	VerifyingContract common.Address
+}
+
+// Bid is the EIP-712 typed-data struct a bidder signs.
+type Bid struct {
+	AuctionID [32]byte
+	Bidder    common.Address
+	Amount    *big.Int
+	Nonce     *big.Int
+	Deadline  *big.Int
+}
+
+// Result is the structured outcome of verifying a signed bid.
+type Result struct {
+	Signer common.Address `json:"signer"`
+	Digest common.Hash    `json:"digest"`
+	Valid  bool           `json:"valid"`
+	Reason string         `json:"reason,omitempty"`
+}
+
+// hashStruct hashes the domain per EIP-712 section "Domain Separator".
+func (d Domain) hashStruct() common.Hash {
+	var buf []byte
+	buf = append(buf, domainTypeHash.Bytes()...)
+	buf = append(buf, crypto.Keccak256([]byte(d.Name))...)
+	buf = append(buf, crypto.Keccak256([]byte(d.Version))...)
+	buf = append(buf, abiword.Pad32(d.ChainID.Bytes())...)
+	buf = append(buf, abiword.Pad32(d.VerifyingContract.Bytes())...)
+	return crypto.Keccak256Hash(buf)
+}
+
+// hashStruct hashes the Bid struct per EIP-712 "hashStruct".
+func (b Bid) hashStruct() common.Hash {
+	var buf []byte
+	buf = append(buf, bidTypeHash.Bytes()...)
+	buf = append(buf, b.AuctionID[:]...)
+	buf = append(buf, abiword.Pad32(b.Bidder.Bytes())...)
+	buf = append(buf, abiword.Pad32(b.Amount.Bytes())...)
+	buf = append(buf, abiword.Pad32(b.Nonce.Bytes())...)
+	buf = append(buf, abiword.Pad32(b.Deadline.Bytes())...)
+	return crypto.Keccak256Hash(buf)
+}
+
+// Digest computes the final EIP-712 digest: keccak256("\x19\x01" || domainSeparator || hashStruct(bid)).
+func Digest(d Domain, b Bid) common.Hash {
+	domainSeparator := d.hashStruct()
+	bidHash := b.hashStruct()
+
+	buf := make([]byte, 0, 2+32+32)
+	buf = append(buf, 0x19, 0x01)
+	buf = append(buf, domainSeparator.Bytes()...)
+	buf = append(buf, bidHash.Bytes()...)
+	return crypto.Keccak256Hash(buf)
+}
+
+// RecoverSigner recovers the address that produced sig over digest. sig must be
+// the standard 65-byte (r, s, v) signature; v may be 0/1 or 27/28.
+func RecoverSigner(digest common.Hash, sig []byte) (common.Address, error) {
+	if len(sig) != 65 {
+		return common.Address{}, fmt.Errorf("bidsig: signature must be 65 bytes, got %d", len(sig))
+	}
+
+	normalized := make([]byte, 65)
+	copy(normalized, sig)
+	if normalized[64] >= 27 {
+		normalized[64] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(digest.Bytes(), normalized)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("bidsig: failed to recover signer: %w", err)
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// NonceKey identifies a single-use bid nonce scoped to an auction and bidder.
+type NonceKey struct {
+	AuctionID [32]byte
+	Bidder    common.Address
+	Nonce     string
+}
+
+// NonceCache is a bounded, in-memory LRU tracking (auctionId, bidder, nonce)
+// tuples that have already been consumed, to reject replayed bid signatures.
+type NonceCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    []NonceKey
+	seen     map[NonceKey]struct{}
+}
+
+// NewNonceCache creates a NonceCache holding at most capacity entries,
+// evicting the oldest entry once full.
+func NewNonceCache(capacity int) *NonceCache {
+	if capacity <= 0 {
+		capacity = 4096
+	}
+	return &NonceCache{
+		capacity: capacity,
+		seen:     make(map[NonceKey]struct{}),
+	}
+}
+
+// MarkIfNew records key as seen and returns true if it was not already
+// present (i.e. the nonce is fresh and the caller may proceed).
+func (c *NonceCache) MarkIfNew(key NonceKey) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.seen[key]; ok {
+		return false
+	}
+
+	if len(c.order) >= c.capacity {
+		oldest := c.order[0]
+		c.order = c.order[1:]
+		delete(c.seen, oldest)
+	}
+
+	c.seen[key] = struct{}{}
+	c.order = append(c.order, key)
+	return true
+}
+
+// Verify checks a signed bid: it recomputes the EIP-712 digest, recovers the
+// signer, confirms the signer matches claimedBidder, enforces the deadline
+// against now, and rejects replayed nonces via cache.
+func Verify(d Domain, b Bid, sig []byte, claimedBidder common.Address, now time.Time, cache *NonceCache) (*Result, error) {
+	digest := Digest(d, b)
+
+	signer, err := RecoverSigner(digest, sig)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Signer: signer, Digest: digest}
+
+	if signer != claimedBidder {
+		result.Reason = "recovered signer does not match claimed bidder"
+		return result, nil
+	}
+
+	if b.Deadline != nil && b.Deadline.Sign() > 0 && now.Unix() > b.Deadline.Int64() {
+		result.Reason = "bid signature deadline has passed"
+		return result, nil
+	}
+
+	key := NonceKey{AuctionID: b.AuctionID, Bidder: claimedBidder, Nonce: b.Nonce.String()}
+	if cache != nil && !cache.MarkIfNew(key) {
+		result.Reason = "bid nonce has already been used"
+		return result, nil
+	}
+
+	result.Valid = true
+	return result, nil
+}
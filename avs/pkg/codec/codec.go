@@ -0,0 +1,75 @@
+// Package codec selects how HandleTask results are serialized. Results are
+// ultimately consumed by an on-chain TaskMailbox, so a task can opt into an
+// RLP encoding that a Solidity contract can decode deterministically,
+// instead of the default JSON.
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Format names a response_format value from a TaskPayload.
+type Format string
+
+const (
+	// FormatJSON is the default: human-readable, used when response_format
+	// is empty or "json".
+	FormatJSON Format = "json"
+	// FormatRLP encodes results as RLP, for on-chain ABI/RLP-decoding.
+	FormatRLP Format = "rlp"
+)
+
+// ResultEncoder serializes a task result struct to bytes.
+type ResultEncoder interface {
+	Encode(v interface{}) ([]byte, error)
+}
+
+// JSONEncoder encodes via encoding/json.
+type JSONEncoder struct{}
+
+func (JSONEncoder) Encode(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// RLPEncoder encodes via go-ethereum's rlp package. It follows go-ethereum's
+// own conventions: a nil pointer encodes as an empty string, a nil or empty
+// byte slice encodes as 0x80, and big.Int encodes as its minimal big-endian
+// representation (zero encodes as 0x80).
+type RLPEncoder struct{}
+
+func (RLPEncoder) Encode(v interface{}) ([]byte, error) {
+	return rlp.EncodeToBytes(v)
+}
+
+// ForFormat resolves the ResultEncoder for a response_format string. An
+// empty string resolves to JSON, matching the pre-existing default so
+// callers that don't set response_format see no behavior change.
+func ForFormat(format string) (ResultEncoder, error) {
+	switch Format(format) {
+	case "", FormatJSON:
+		return JSONEncoder{}, nil
+	case FormatRLP:
+		return RLPEncoder{}, nil
+	default:
+		return nil, fmt.Errorf("codec: unknown response_format %q", format)
+	}
+}
+
+// Encode picks an encoder for format and applies it to jsonValue, unless
+// format resolves to RLP, in which case it applies to rlpValue instead.
+// Handlers pass their natural JSON-friendly result as jsonValue and an
+// RLP-tagged struct (see types.go) as rlpValue.
+func Encode(format string, jsonValue, rlpValue interface{}) ([]byte, error) {
+	encoder, err := ForFormat(format)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, isRLP := encoder.(RLPEncoder); isRLP {
+		return encoder.Encode(rlpValue)
+	}
+	return encoder.Encode(jsonValue)
+}
@@ -0,0 +1,153 @@
+package codec
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func TestRLPRoundTripSettlementResult(t *testing.T) {
+	want := SettlementResult{
+		AuctionId:     common.HexToHash("0x123"),
+		Winner:        common.HexToAddress("0xabc"),
+		Amount:        big.NewInt(500),
+		ClearingPrice: big.NewInt(300),
+	}
+
+	encoded, err := Encode("rlp", nil, want)
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	var got SettlementResult
+	if err := rlp.DecodeBytes(encoded, &got); err != nil {
+		t.Fatalf("DecodeBytes failed: %v", err)
+	}
+
+	if got.Winner != want.Winner || got.AuctionId != want.AuctionId {
+		t.Errorf("round trip mismatch: got %+v, want %+v", got, want)
+	}
+	if got.Amount.Cmp(want.Amount) != 0 || got.ClearingPrice.Cmp(want.ClearingPrice) != 0 {
+		t.Errorf("round trip amount mismatch: got %+v, want %+v", got, want)
+	}
+}
+
+func TestRLPZeroAndNilEdgeCases(t *testing.T) {
+	// A zero big.Int encodes as 0x80 (empty string), and decodes back to
+	// zero rather than erroring.
+	zero := SettlementResult{Amount: big.NewInt(0), ClearingPrice: big.NewInt(0)}
+	encoded, err := Encode("rlp", nil, zero)
+	if err != nil {
+		t.Fatalf("Encode zero big.Int failed: %v", err)
+	}
+
+	var decoded SettlementResult
+	if err := rlp.DecodeBytes(encoded, &decoded); err != nil {
+		t.Fatalf("DecodeBytes zero big.Int failed: %v", err)
+	}
+	if decoded.Amount.Sign() != 0 || decoded.ClearingPrice.Sign() != 0 {
+		t.Errorf("expected zero amounts to round-trip as zero, got %+v", decoded)
+	}
+
+	// A nil *big.Int encodes as an empty string too (go-ethereum's rlp
+	// treats a nil pointer the same as its zero value), so this must not error.
+	var nilAmount SettlementResult
+	if _, err := Encode("rlp", nil, nilAmount); err != nil {
+		t.Errorf("expected nil *big.Int fields to encode without error, got: %v", err)
+	}
+}
+
+func TestRLPRoundTripBidValidationResult(t *testing.T) {
+	want := BidValidationResult{
+		Signer: common.HexToAddress("0xabc"),
+		Digest: common.HexToHash("0x456"),
+		Valid:  true,
+	}
+
+	encoded, err := Encode("rlp", nil, want)
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	var got BidValidationResult
+	if err := rlp.DecodeBytes(encoded, &got); err != nil {
+		t.Fatalf("DecodeBytes failed: %v", err)
+	}
+	if got != want {
+		t.Errorf("round trip mismatch: got %+v, want %+v", got, want)
+	}
+}
+
+func TestRLPRoundTripAuctionCreationResult(t *testing.T) {
+	want := AuctionCreationResult{
+		AuctionId: common.HexToHash("0x123"),
+		PoolId:    "0xabcdef",
+		MinBid:    big.NewInt(100),
+		CreatedAt: 1700000000,
+	}
+
+	encoded, err := Encode("rlp", nil, want)
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	var got AuctionCreationResult
+	if err := rlp.DecodeBytes(encoded, &got); err != nil {
+		t.Fatalf("DecodeBytes failed: %v", err)
+	}
+	if got.AuctionId != want.AuctionId || got.PoolId != want.PoolId || got.CreatedAt != want.CreatedAt {
+		t.Errorf("round trip mismatch: got %+v, want %+v", got, want)
+	}
+	if got.MinBid.Cmp(want.MinBid) != 0 {
+		t.Errorf("round trip MinBid mismatch: got %s, want %s", got.MinBid, want.MinBid)
+	}
+}
+
+func TestRLPRoundTripMonitoringResult(t *testing.T) {
+	want := MonitoringResult{
+		PoolAddress:  common.HexToAddress("0xdef"),
+		PoolPrice:    big.NewInt(123456),
+		OraclePrice:  big.NewInt(123000),
+		DeviationBps: 37,
+		BlockNumber:  9001,
+	}
+
+	encoded, err := Encode("rlp", nil, want)
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+
+	var got MonitoringResult
+	if err := rlp.DecodeBytes(encoded, &got); err != nil {
+		t.Fatalf("DecodeBytes failed: %v", err)
+	}
+	if got.PoolAddress != want.PoolAddress || got.DeviationBps != want.DeviationBps || got.BlockNumber != want.BlockNumber {
+		t.Errorf("round trip mismatch: got %+v, want %+v", got, want)
+	}
+	if got.PoolPrice.Cmp(want.PoolPrice) != 0 || got.OraclePrice.Cmp(want.OraclePrice) != 0 {
+		t.Errorf("round trip price mismatch: got %+v, want %+v", got, want)
+	}
+}
+
+func TestForFormatUnknown(t *testing.T) {
+	if _, err := ForFormat("xml"); err == nil {
+		t.Error("expected an error for an unrecognized response_format")
+	}
+}
+
+func TestEncodeDefaultsToJSON(t *testing.T) {
+	type simple struct {
+		Name string `json:"name"`
+	}
+	v := simple{Name: "shield"}
+
+	encoded, err := Encode("", v, nil)
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	if string(encoded) != `{"name":"shield"}` {
+		t.Errorf("expected JSON output, got %s", encoded)
+	}
+}
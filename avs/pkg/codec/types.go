@@ -0,0 +1,45 @@
+package codec
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SettlementResult is the RLP-encodable settlement outcome a Solidity
+// TaskMailbox consumer can RLP-decode directly.
+type SettlementResult struct {
+	AuctionId     [32]byte
+	Winner        common.Address
+	Amount        *big.Int
+	ClearingPrice *big.Int
+}
+
+// BidValidationResult is the RLP-encodable outcome of verifying an EIP-712
+// signed bid.
+type BidValidationResult struct {
+	Signer common.Address
+	Digest [32]byte
+	Valid  bool
+}
+
+// AuctionCreationResult is the RLP-encodable outcome of creating (or
+// replaying the creation of) an auction.
+type AuctionCreationResult struct {
+	AuctionId [32]byte
+	PoolId    string
+	MinBid    *big.Int
+	CreatedAt uint64
+}
+
+// MonitoringResult is the RLP-encodable outcome of a Shield monitoring
+// evaluation. DeviationBps is unsigned: go-ethereum's rlp package cannot
+// encode signed integer types at all, and deviationBps (oracle.go) always
+// computes a non-negative basis-point magnitude, so no sign is lost.
+type MonitoringResult struct {
+	PoolAddress  common.Address
+	PoolPrice    *big.Int
+	OraclePrice  *big.Int
+	DeviationBps uint64
+	BlockNumber  uint64
+}
@@ -0,0 +1,83 @@
+// Package concurrency provides per-key serialization primitives for the
+// Shield AVS performer, so operations that share state (e.g. two tasks
+// touching the same auction) cannot interleave their mutations while
+// operations on unrelated keys still run in parallel.
+package concurrency
+
+import (
+	"context"
+	"sync"
+)
+
+// entry is the per-key lock, reference-counted so KeyedMutex can forget
+// keys once nobody is waiting on them instead of growing unbounded.
+type entry struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// KeyedMutex hands out a mutex per string key, lazily creating it on first
+// use and discarding it once its last holder releases it. Distinct keys
+// never block each other; the same key serializes all callers.
+type KeyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*entry
+}
+
+// New builds an empty KeyedMutex.
+func New() *KeyedMutex {
+	return &KeyedMutex{locks: make(map[string]*entry)}
+}
+
+// acquire returns the entry for key with its lock held, registering it
+// (and bumping its refcount) first if this is the first waiter.
+func (k *KeyedMutex) acquire(key string) *entry {
+	k.mu.Lock()
+	e, ok := k.locks[key]
+	if !ok {
+		e = &entry{}
+		k.locks[key] = e
+	}
+	e.refs++
+	k.mu.Unlock()
+
+	e.mu.Lock()
+	return e
+}
+
+// release unlocks e and, if no other caller is waiting on key, removes it
+// from the map so KeyedMutex doesn't accumulate one entry per key forever.
+func (k *KeyedMutex) release(key string, e *entry) {
+	e.mu.Unlock()
+
+	k.mu.Lock()
+	e.refs--
+	if e.refs == 0 {
+		delete(k.locks, key)
+	}
+	k.mu.Unlock()
+}
+
+// Do runs fn while holding key's lock, serializing it against any other Do
+// call for the same key. If ctx is cancelled before the lock is acquired,
+// Do returns ctx.Err() without calling fn; the pending acquisition is still
+// released once it completes, so a cancelled caller never leaks the lock
+// for callers still waiting behind it.
+func (k *KeyedMutex) Do(ctx context.Context, key string, fn func() error) error {
+	acquired := make(chan *entry, 1)
+	go func() {
+		acquired <- k.acquire(key)
+	}()
+
+	select {
+	case <-ctx.Done():
+		go func() {
+			e := <-acquired
+			k.release(key, e)
+		}()
+		return ctx.Err()
+	case e := <-acquired:
+		defer k.release(key, e)
+		return fn()
+	}
+}
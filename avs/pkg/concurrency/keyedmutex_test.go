@@ -0,0 +1,101 @@
+package concurrency
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestKeyedMutexSerializesSameKey(t *testing.T) {
+	k := New()
+
+	var active int32
+	var maxActive int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = k.Do(context.Background(), "auction-1", func() error {
+				n := atomic.AddInt32(&active, 1)
+				for {
+					old := atomic.LoadInt32(&maxActive)
+					if n <= old || atomic.CompareAndSwapInt32(&maxActive, old, n) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				atomic.AddInt32(&active, -1)
+				return nil
+			})
+		}()
+	}
+	wg.Wait()
+
+	if maxActive != 1 {
+		t.Errorf("expected at most 1 concurrent holder for the same key, saw %d", maxActive)
+	}
+}
+
+func TestKeyedMutexAllowsDistinctKeysInParallel(t *testing.T) {
+	k := New()
+
+	const n = 10
+	release := make(chan struct{})
+	entered := make(chan struct{}, n)
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		key := string(rune('a' + i))
+		go func() {
+			defer wg.Done()
+			_ = k.Do(context.Background(), key, func() error {
+				entered <- struct{}{}
+				<-release
+				return nil
+			})
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		select {
+		case <-entered:
+		case <-time.After(time.Second):
+			t.Fatalf("distinct keys did not all run concurrently")
+		}
+	}
+	close(release)
+	wg.Wait()
+}
+
+func TestKeyedMutexContextCancellation(t *testing.T) {
+	k := New()
+
+	holding := make(chan struct{})
+	release := make(chan struct{})
+	go func() {
+		_ = k.Do(context.Background(), "auction-1", func() error {
+			close(holding)
+			<-release
+			return nil
+		})
+	}()
+	<-holding
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := k.Do(ctx, "auction-1", func() error {
+		t.Fatal("fn should not run once the context is cancelled")
+		return nil
+	})
+	if err != context.DeadlineExceeded {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	close(release)
+}
@@ -0,0 +1,34 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// latestRoundDataSelector is the 4-byte selector for Chainlink
+// AggregatorV3Interface's `latestRoundData()`, which returns
+// (roundId, answer, startedAt, updatedAt, answeredInRound) as five
+// ABI-encoded 32-byte words.
+var latestRoundDataSelector = crypto.Keccak256([]byte("latestRoundData()"))[:4]
+
+// fetchChainlinkPrice calls latestRoundData() on feed and returns the
+// reported answer along with its updatedAt timestamp.
+func fetchChainlinkPrice(ctx context.Context, client *failoverClient, feed common.Address) (*big.Int, time.Time, error) {
+	result, _, err := client.call(ctx, feed, latestRoundDataSelector)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if len(result) < 32*5 {
+		return nil, time.Time{}, fmt.Errorf("oracle: latestRoundData() returned %d bytes, expected at least %d", len(result), 32*5)
+	}
+
+	answer := new(big.Int).SetBytes(result[32:64])
+	updatedAtSeconds := new(big.Int).SetBytes(result[96:128])
+
+	return answer, time.Unix(updatedAtSeconds.Int64(), 0), nil
+}
@@ -0,0 +1,97 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// rpcClient is the subset of *ethclient.Client that failoverClient depends
+// on, so tests can inject a stub RPC endpoint instead of dialing a real one.
+type rpcClient interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// failoverClient calls a contract method against a list of RPC endpoints,
+// retrying each endpoint with backoff before failing over to the next one.
+// Clients are dialed lazily and cached for reuse.
+type failoverClient struct {
+	endpoints []string
+	retries   int
+	backoff   time.Duration
+
+	dialFunc func(endpoint string) (rpcClient, error)
+	clients  map[string]rpcClient
+}
+
+func newFailoverClient(endpoints []string, retries int, backoff time.Duration) *failoverClient {
+	return &failoverClient{
+		endpoints: endpoints,
+		retries:   retries,
+		backoff:   backoff,
+		dialFunc: func(endpoint string) (rpcClient, error) {
+			return ethclient.Dial(endpoint)
+		},
+		clients: make(map[string]rpcClient),
+	}
+}
+
+func (f *failoverClient) dial(endpoint string) (rpcClient, error) {
+	if c, ok := f.clients[endpoint]; ok {
+		return c, nil
+	}
+
+	c, err := f.dialFunc(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	f.clients[endpoint] = c
+	return c, nil
+}
+
+// call performs an eth_call against to with the given calldata, retrying
+// transient failures on each endpoint before moving to the next one in the
+// pool. It also returns the endpoint's current block number.
+func (f *failoverClient) call(ctx context.Context, to common.Address, data []byte) ([]byte, uint64, error) {
+	var lastErr error
+
+	for _, endpoint := range f.endpoints {
+		client, err := f.dial(endpoint)
+		if err != nil {
+			lastErr = fmt.Errorf("dial %s: %w", endpoint, err)
+			continue
+		}
+
+		for attempt := 0; attempt <= f.retries; attempt++ {
+			if attempt > 0 {
+				select {
+				case <-ctx.Done():
+					return nil, 0, ctx.Err()
+				case <-time.After(f.backoff * time.Duration(attempt)):
+				}
+			}
+
+			result, callErr := client.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, nil)
+			if callErr != nil {
+				lastErr = fmt.Errorf("%s: %w", endpoint, callErr)
+				continue
+			}
+
+			blockNumber, bnErr := client.BlockNumber(ctx)
+			if bnErr != nil {
+				lastErr = fmt.Errorf("%s: %w", endpoint, bnErr)
+				continue
+			}
+
+			return result, blockNumber, nil
+		}
+	}
+
+	return nil, 0, fmt.Errorf("oracle: all %d RPC endpoints failed: %w", len(f.endpoints), lastErr)
+}
@@ -0,0 +1,117 @@
+// Package oracle monitors the deviation between an on-chain pool price and a
+// Chainlink reference price, so handleShieldMonitoring can decide whether a
+// Shield auction should be triggered.
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Config describes the deployment this Monitor watches.
+type Config struct {
+	// RPCEndpoints is tried in order on each call, failing over to the next
+	// endpoint when one is unreachable or errors.
+	RPCEndpoints []string
+
+	PoolAddress   common.Address
+	ChainlinkFeed common.Address
+
+	// StalenessThreshold is the maximum age a Chainlink round may have
+	// before Monitor refuses to trust it.
+	StalenessThreshold time.Duration
+
+	// Retries and RetryBackoff bound the retry/backoff policy applied to
+	// each RPC endpoint before failing over to the next one.
+	Retries      int
+	RetryBackoff time.Duration
+}
+
+// PriceReport is the signed result of a single monitoring evaluation.
+type PriceReport struct {
+	PoolAddress          common.Address `json:"pool_address"`
+	PoolPrice            *big.Int       `json:"pool_price"`
+	OraclePrice          *big.Int       `json:"oracle_price"`
+	DeviationBps         int64          `json:"deviation_bps"`
+	BlockNumber          uint64         `json:"block_number"`
+	ShouldTriggerAuction bool           `json:"should_trigger_auction"`
+}
+
+// Monitor evaluates pool-vs-oracle price deviation over a failover pool of
+// JSON-RPC endpoints.
+type Monitor struct {
+	cfg    Config
+	client *failoverClient
+}
+
+// NewMonitor builds a Monitor. It does not dial any endpoint until Evaluate
+// is called.
+func NewMonitor(cfg Config) (*Monitor, error) {
+	if len(cfg.RPCEndpoints) == 0 {
+		return nil, fmt.Errorf("oracle: at least one RPC endpoint is required")
+	}
+	if cfg.StalenessThreshold <= 0 {
+		cfg.StalenessThreshold = 1 * time.Hour
+	}
+	if cfg.Retries <= 0 {
+		cfg.Retries = 3
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = 250 * time.Millisecond
+	}
+
+	return &Monitor{
+		cfg:    cfg,
+		client: newFailoverClient(cfg.RPCEndpoints, cfg.Retries, cfg.RetryBackoff),
+	}, nil
+}
+
+// Evaluate fetches the current pool price and Chainlink reference price,
+// computes their deviation in basis points, and reports whether it meets or
+// exceeds thresholdBps.
+func (m *Monitor) Evaluate(ctx context.Context, thresholdBps int64) (*PriceReport, error) {
+	poolPrice, blockNumber, err := fetchPoolPrice(ctx, m.client, m.cfg.PoolAddress)
+	if err != nil {
+		return nil, fmt.Errorf("oracle: failed to fetch pool price: %w", err)
+	}
+
+	oraclePrice, updatedAt, err := fetchChainlinkPrice(ctx, m.client, m.cfg.ChainlinkFeed)
+	if err != nil {
+		return nil, fmt.Errorf("oracle: failed to fetch chainlink price: %w", err)
+	}
+
+	if age := time.Since(updatedAt); age > m.cfg.StalenessThreshold {
+		return nil, fmt.Errorf("oracle: chainlink feed %s is stale (last updated %s ago)", m.cfg.ChainlinkFeed, age)
+	}
+
+	deviationBps := deviationBps(poolPrice, oraclePrice)
+
+	return &PriceReport{
+		PoolAddress:          m.cfg.PoolAddress,
+		PoolPrice:            poolPrice,
+		OraclePrice:          oraclePrice,
+		DeviationBps:         deviationBps,
+		BlockNumber:          blockNumber,
+		ShouldTriggerAuction: deviationBps >= thresholdBps,
+	}, nil
+}
+
+// deviationBps returns the absolute deviation of a from b, in basis points
+// of b. Returns 0 if b is zero to avoid a division by zero.
+func deviationBps(a, b *big.Int) int64 {
+	if b.Sign() == 0 {
+		return 0
+	}
+
+	diff := new(big.Int).Sub(a, b)
+	diff.Abs(diff)
+
+	bps := new(big.Int).Mul(diff, big.NewInt(10_000))
+	bps.Quo(bps, new(big.Int).Abs(b))
+
+	return bps.Int64()
+}
@@ -0,0 +1,137 @@
+package oracle
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// stubRPCClient is a scripted rpcClient: each CallContract call pops the
+// next entry off errs/results, and BlockNumber always succeeds.
+type stubRPCClient struct {
+	results [][]byte
+	errs    []error
+	calls   int
+}
+
+func (s *stubRPCClient) CallContract(_ context.Context, _ ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	i := s.calls
+	s.calls++
+	if i < len(s.errs) && s.errs[i] != nil {
+		return nil, s.errs[i]
+	}
+	if i < len(s.results) {
+		return s.results[i], nil
+	}
+	return s.results[len(s.results)-1], nil
+}
+
+func (s *stubRPCClient) BlockNumber(_ context.Context) (uint64, error) {
+	return 42, nil
+}
+
+// encodeRoundData builds a latestRoundData()-shaped 160-byte ABI return:
+// (roundId, answer, startedAt, updatedAt, answeredInRound).
+func encodeRoundData(answer int64, updatedAt time.Time) []byte {
+	buf := make([]byte, 32*5)
+	big.NewInt(answer).FillBytes(buf[32:64])
+	binary.BigEndian.PutUint64(buf[120:128], uint64(updatedAt.Unix()))
+	return buf
+}
+
+func TestDeviationBps(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b int64
+		want int64
+	}{
+		{name: "no deviation", a: 100, b: 100, want: 0},
+		{name: "pool above oracle", a: 110, b: 100, want: 1000},
+		{name: "pool below oracle", a: 90, b: 100, want: 1000},
+		{name: "zero reference", a: 100, b: 0, want: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := deviationBps(big.NewInt(tc.a), big.NewInt(tc.b))
+			if got != tc.want {
+				t.Errorf("deviationBps(%d, %d) = %d, want %d", tc.a, tc.b, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFailoverClientFailsOverToSecondEndpoint(t *testing.T) {
+	bad := &stubRPCClient{errs: []error{errors.New("connection refused")}}
+	good := &stubRPCClient{results: [][]byte{[]byte("ok")}}
+
+	f := newFailoverClient([]string{"https://rpc-1", "https://rpc-2"}, 0, time.Millisecond)
+	f.dialFunc = func(endpoint string) (rpcClient, error) {
+		if endpoint == "https://rpc-1" {
+			return bad, nil
+		}
+		return good, nil
+	}
+
+	result, blockNumber, err := f.call(context.Background(), common.HexToAddress("0x1"), nil)
+	if err != nil {
+		t.Fatalf("call failed: %v", err)
+	}
+	if string(result) != "ok" {
+		t.Errorf("expected result from the second endpoint, got %q", result)
+	}
+	if blockNumber != 42 {
+		t.Errorf("expected block number 42, got %d", blockNumber)
+	}
+	if good.calls != 1 {
+		t.Errorf("expected exactly 1 call to the healthy endpoint, got %d", good.calls)
+	}
+}
+
+func TestFailoverClientExhaustsRetries(t *testing.T) {
+	client := &stubRPCClient{errs: []error{
+		errors.New("timeout"),
+		errors.New("timeout"),
+		errors.New("timeout"),
+	}}
+
+	f := newFailoverClient([]string{"https://rpc-1"}, 2, time.Millisecond)
+	f.dialFunc = func(string) (rpcClient, error) { return client, nil }
+
+	_, _, err := f.call(context.Background(), common.HexToAddress("0x1"), nil)
+	if err == nil {
+		t.Fatal("expected an error once all endpoints and retries are exhausted")
+	}
+	if client.calls != 3 {
+		t.Errorf("expected 1 initial attempt + 2 retries = 3 calls, got %d", client.calls)
+	}
+}
+
+func TestEvaluateRejectsStaleChainlinkRound(t *testing.T) {
+	poolResult := make([]byte, 32)
+	new(big.Int).Lsh(big.NewInt(1), 96).FillBytes(poolResult) // sqrtPriceX96 = 2^96 -> price = 1e18
+	staleResult := encodeRoundData(100, time.Now().Add(-2*time.Hour))
+
+	client := &stubRPCClient{results: [][]byte{poolResult, staleResult}}
+
+	m := &Monitor{
+		cfg: Config{
+			PoolAddress:        common.HexToAddress("0xaaaa"),
+			ChainlinkFeed:      common.HexToAddress("0xbbbb"),
+			StalenessThreshold: time.Hour,
+		},
+		client: newFailoverClient([]string{"https://rpc-1"}, 0, time.Millisecond),
+	}
+	m.client.dialFunc = func(string) (rpcClient, error) { return client, nil }
+
+	_, err := m.Evaluate(context.Background(), 100)
+	if err == nil {
+		t.Fatal("expected an error for a stale chainlink round")
+	}
+}
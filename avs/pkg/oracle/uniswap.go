@@ -0,0 +1,40 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// slot0Selector is the 4-byte selector for Uniswap V3/V4-style
+// `slot0()`, whose first return word is `sqrtPriceX96`.
+var slot0Selector = crypto.Keccak256([]byte("slot0()"))[:4]
+
+// q96 is 2**96, the fixed-point scale Uniswap uses for sqrtPriceX96.
+var q96 = new(big.Int).Lsh(big.NewInt(1), 96)
+
+// fetchPoolPrice calls slot0() on pool and converts sqrtPriceX96 into a
+// plain price (token1 per token0, scaled by 1e18) along with the block the
+// call was evaluated against.
+func fetchPoolPrice(ctx context.Context, client *failoverClient, pool common.Address) (*big.Int, uint64, error) {
+	result, blockNumber, err := client.call(ctx, pool, slot0Selector)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(result) < 32 {
+		return nil, 0, fmt.Errorf("oracle: slot0() returned %d bytes, expected at least 32", len(result))
+	}
+
+	sqrtPriceX96 := new(big.Int).SetBytes(result[:32])
+
+	// price = (sqrtPriceX96 / 2^96)^2, scaled to 1e18 fixed point.
+	price := new(big.Int).Mul(sqrtPriceX96, sqrtPriceX96)
+	price.Mul(price, big.NewInt(1e18))
+	price.Quo(price, q96)
+	price.Quo(price, q96)
+
+	return price, blockNumber, nil
+}
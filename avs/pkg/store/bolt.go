@@ -0,0 +1,180 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/auction"
+	bolt "go.etcd.io/bbolt"
+)
+
+var auctionsBucket = []byte("auctions")
+
+// BoltStore is a BoltDB-backed AuctionStore. Auction lifecycle survives
+// performer restarts and re-org replays, which an in-memory store cannot
+// offer.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens (creating if necessary) a BoltDB file at path and
+// ensures the auctions bucket exists.
+func NewBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("store: failed to open bolt db at %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(auctionsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("store: failed to initialize auctions bucket: %w", err)
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+// Close releases the underlying BoltDB file handle.
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *BoltStore) CreateAuction(_ context.Context, record *AuctionRecord) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(auctionsBucket)
+		if b.Get([]byte(record.ID)) != nil {
+			return fmt.Errorf("store: auction %s already exists", record.ID)
+		}
+
+		data, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("store: failed to encode auction %s: %w", record.ID, err)
+		}
+		return b.Put([]byte(record.ID), data)
+	})
+}
+
+func (s *BoltStore) RecordBid(_ context.Context, auctionID string, bid BidRecord) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(auctionsBucket)
+		data := b.Get([]byte(auctionID))
+		if data == nil {
+			return ErrNotFound
+		}
+
+		var record AuctionRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return fmt.Errorf("store: failed to decode auction %s: %w", auctionID, err)
+		}
+
+		record.Bids = append(record.Bids, bid)
+
+		encoded, err := json.Marshal(&record)
+		if err != nil {
+			return fmt.Errorf("store: failed to encode auction %s: %w", auctionID, err)
+		}
+		return b.Put([]byte(auctionID), encoded)
+	})
+}
+
+func (s *BoltStore) GetAuction(_ context.Context, auctionID string) (*AuctionRecord, error) {
+	var record AuctionRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(auctionsBucket).Get([]byte(auctionID))
+		if data == nil {
+			return ErrNotFound
+		}
+		return json.Unmarshal(data, &record)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func (s *BoltStore) SettleAuction(_ context.Context, auctionID string, result []byte, bidProofs map[string]auction.Proof) ([]byte, map[string]auction.Proof, bool, error) {
+	var stored []byte
+	var storedProofs map[string]auction.Proof
+	var alreadySettled bool
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(auctionsBucket)
+		data := b.Get([]byte(auctionID))
+		if data == nil {
+			return ErrNotFound
+		}
+
+		var record AuctionRecord
+		if err := json.Unmarshal(data, &record); err != nil {
+			return fmt.Errorf("store: failed to decode auction %s: %w", auctionID, err)
+		}
+
+		if record.Status == AuctionStatusSettled {
+			alreadySettled = true
+			stored = record.SettlementResult
+			storedProofs = record.BidProofs
+			return nil
+		}
+
+		record.Status = AuctionStatusSettled
+		record.SettlementResult = result
+		record.BidProofs = bidProofs
+		stored = result
+		storedProofs = bidProofs
+
+		encoded, err := json.Marshal(&record)
+		if err != nil {
+			return fmt.Errorf("store: failed to encode auction %s: %w", auctionID, err)
+		}
+		return b.Put([]byte(auctionID), encoded)
+	})
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return stored, storedProofs, alreadySettled, nil
+}
+
+func (s *BoltStore) GetBidProof(_ context.Context, auctionID, bidder string) (*auction.Proof, error) {
+	var record AuctionRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(auctionsBucket).Get([]byte(auctionID))
+		if data == nil {
+			return ErrNotFound
+		}
+		return json.Unmarshal(data, &record)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proof, ok := record.BidProofs[bidder]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &proof, nil
+}
+
+func (s *BoltStore) ListActive(_ context.Context) ([]*AuctionRecord, error) {
+	active := make([]*AuctionRecord, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(auctionsBucket).ForEach(func(_, data []byte) error {
+			var record AuctionRecord
+			if err := json.Unmarshal(data, &record); err != nil {
+				return err
+			}
+			if record.Status != AuctionStatusSettled {
+				cp := record
+				active = append(active, &cp)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return active, nil
+}
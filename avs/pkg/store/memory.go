@@ -0,0 +1,111 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/auction"
+)
+
+// MemoryStore is an in-memory AuctionStore. It does not survive process
+// restarts and is intended for local development and tests.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	auctions map[string]*AuctionRecord
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		auctions: make(map[string]*AuctionRecord),
+	}
+}
+
+func (s *MemoryStore) CreateAuction(_ context.Context, record *AuctionRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.auctions[record.ID]; exists {
+		return fmt.Errorf("store: auction %s already exists", record.ID)
+	}
+
+	cp := *record
+	s.auctions[record.ID] = &cp
+	return nil
+}
+
+func (s *MemoryStore) RecordBid(_ context.Context, auctionID string, bid BidRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.auctions[auctionID]
+	if !ok {
+		return ErrNotFound
+	}
+
+	record.Bids = append(record.Bids, bid)
+	return nil
+}
+
+func (s *MemoryStore) GetAuction(_ context.Context, auctionID string) (*AuctionRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	record, ok := s.auctions[auctionID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	cp := *record
+	return &cp, nil
+}
+
+func (s *MemoryStore) SettleAuction(_ context.Context, auctionID string, result []byte, bidProofs map[string]auction.Proof) ([]byte, map[string]auction.Proof, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.auctions[auctionID]
+	if !ok {
+		return nil, nil, false, ErrNotFound
+	}
+
+	if record.Status == AuctionStatusSettled {
+		return record.SettlementResult, record.BidProofs, true, nil
+	}
+
+	record.Status = AuctionStatusSettled
+	record.SettlementResult = result
+	record.BidProofs = bidProofs
+	return result, bidProofs, false, nil
+}
+
+func (s *MemoryStore) GetBidProof(_ context.Context, auctionID, bidder string) (*auction.Proof, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	record, ok := s.auctions[auctionID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+
+	proof, ok := record.BidProofs[bidder]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &proof, nil
+}
+
+func (s *MemoryStore) ListActive(_ context.Context) ([]*AuctionRecord, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	active := make([]*AuctionRecord, 0)
+	for _, record := range s.auctions {
+		if record.Status != AuctionStatusSettled {
+			cp := *record
+			active = append(active, &cp)
+		}
+	}
+	return active, nil
+}
@@ -0,0 +1,80 @@
+// Package store defines the persistence boundary for Shield auction state,
+// so auction lifecycle survives performer restarts and re-org replays.
+package store
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/Samuel1505/Shield-Auction-Hook/avs/pkg/auction"
+)
+
+// ErrNotFound is returned when an auction ID has no corresponding record.
+var ErrNotFound = errors.New("store: auction not found")
+
+// AuctionStatus tracks where an auction is in its lifecycle.
+type AuctionStatus string
+
+const (
+	AuctionStatusOpen    AuctionStatus = "open"
+	AuctionStatusSettled AuctionStatus = "settled"
+)
+
+// BidRecord is a single recorded bid against an auction.
+type BidRecord struct {
+	Bidder     string    `json:"bidder"`
+	Amount     *big.Int  `json:"amount"`
+	Nonce      string    `json:"nonce"`
+	RecordedAt time.Time `json:"recorded_at"`
+}
+
+// AuctionRecord is the durable representation of one Shield auction.
+type AuctionRecord struct {
+	ID               string        `json:"id"`
+	PoolID           string        `json:"pool_id"`
+	Duration         time.Duration `json:"duration"`
+	MinBid           *big.Int      `json:"min_bid"`
+	CreatedAt        time.Time     `json:"created_at"`
+	Status           AuctionStatus `json:"status"`
+	Bids             []BidRecord   `json:"bids"`
+	SettlementResult []byte        `json:"settlement_result,omitempty"`
+
+	// BidProofs holds, per settled auction, each revealed bidder's Merkle
+	// inclusion proof against SettlementResult's MerkleRootOfBids, keyed
+	// by the bidder's hex address (common.Address.Hex()). This is what
+	// lets a bidder obtain a compact proof of their own outcome instead
+	// of needing every other revealed bid to recompute the root.
+	BidProofs map[string]auction.Proof `json:"bid_proofs,omitempty"`
+}
+
+// AuctionStore is the persistence interface ShieldAuctionPerformer depends on.
+// Implementations must be safe for concurrent use.
+type AuctionStore interface {
+	// CreateAuction persists a new auction. It returns an error if an
+	// auction with the same ID already exists.
+	CreateAuction(ctx context.Context, auction *AuctionRecord) error
+
+	// RecordBid appends a bid to an existing auction.
+	RecordBid(ctx context.Context, auctionID string, bid BidRecord) error
+
+	// GetAuction returns the auction record for auctionID, or ErrNotFound.
+	GetAuction(ctx context.Context, auctionID string) (*AuctionRecord, error)
+
+	// SettleAuction marks the auction settled and stores result as its
+	// canonical settlement bytes, alongside each revealed bidder's Merkle
+	// inclusion proof. If the auction was already settled, SettleAuction
+	// is a no-op and returns the previously stored result bytes and
+	// proofs rather than overwriting them, so repeated settlement tasks
+	// converge on the same output across operators.
+	SettleAuction(ctx context.Context, auctionID string, result []byte, bidProofs map[string]auction.Proof) (stored []byte, storedProofs map[string]auction.Proof, alreadySettled bool, err error)
+
+	// GetBidProof returns the Merkle inclusion proof recorded for bidder
+	// (its hex address) at auctionID's settlement, or ErrNotFound if the
+	// auction isn't settled or bidder didn't have a validly revealed bid.
+	GetBidProof(ctx context.Context, auctionID, bidder string) (*auction.Proof, error)
+
+	// ListActive returns all auctions that have not yet been settled.
+	ListActive(ctx context.Context) ([]*AuctionRecord, error)
+}
@@ -0,0 +1,48 @@
+package validation
+
+import "errors"
+
+// Sentinel errors returned by the Parse*Params functions, so callers (and
+// operators reading logs) can distinguish rejection reasons without parsing
+// error strings.
+var (
+	// ErrUnknownFields is returned when a payload contains a field not
+	// present in the target schema.
+	ErrUnknownFields = errors.New("validation: payload contains unknown fields")
+
+	// ErrMissingField is returned when a required field is empty or absent.
+	ErrMissingField = errors.New("validation: missing required field")
+
+	// ErrInvalidAddress is returned when a field expected to be a 20-byte
+	// hex address is malformed.
+	ErrInvalidAddress = errors.New("validation: invalid hex address")
+
+	// ErrInvalidHash is returned when a field expected to be a 32-byte hex
+	// hash or identifier is malformed.
+	ErrInvalidHash = errors.New("validation: invalid hex hash")
+
+	// ErrInvalidSignature is returned when a field expected to be a 65-byte
+	// hex-encoded signature is malformed.
+	ErrInvalidSignature = errors.New("validation: invalid signature encoding")
+
+	// ErrNotNumeric is returned when a field expected to be a base-10 or
+	// 0x-prefixed integer string cannot be parsed as one, or parses to a
+	// negative value or one wider than a uint256.
+	ErrNotNumeric = errors.New("validation: field is not a valid integer")
+
+	// ErrThresholdOutOfRange is returned when a basis-point threshold falls
+	// outside (0, 10000].
+	ErrThresholdOutOfRange = errors.New("validation: threshold out of range")
+
+	// ErrDurationOutOfRange is returned when a duration falls outside the
+	// accepted range for its field.
+	ErrDurationOutOfRange = errors.New("validation: duration out of range")
+
+	// ErrDeadlineInPast is returned when a deadline field resolves to a
+	// unix timestamp at or before the current time.
+	ErrDeadlineInPast = errors.New("validation: deadline must be in the future")
+
+	// ErrEmptyCollection is returned when a required slice field has no
+	// elements.
+	ErrEmptyCollection = errors.New("validation: expected at least one element")
+)
@@ -0,0 +1,167 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	validator "github.com/go-playground/validator/v10"
+)
+
+var v = newValidator()
+
+// ShieldMonitoringParams is the strict schema for a shield_monitoring task's
+// parameters.
+type ShieldMonitoringParams struct {
+	PoolAddress      string   `json:"pool_address" validate:"required,hexaddress"`
+	ChainlinkFeed    string   `json:"chainlink_feed" validate:"required,hexaddress"`
+	RPCEndpoints     []string `json:"rpc_endpoints" validate:"required,min=1,dive,url"`
+	ThresholdBps     int64    `json:"threshold" validate:"required,gt=0,lte=10000"`
+	StalenessSeconds int64    `json:"staleness_seconds" validate:"gte=0"`
+}
+
+// AuctionCreationParams is the strict schema for an auction_creation task's
+// parameters. CreatedAt is supplied by the task submitter rather than
+// stamped locally by each operator, so every operator signs the same
+// AuctionRecord.CreatedAt for a given task.
+type AuctionCreationParams struct {
+	AuctionID string `json:"auction_id" validate:"required,hexhash"`
+	PoolID    string `json:"pool_id" validate:"required"`
+	Duration  int64  `json:"duration" validate:"required,gt=0,lte=604800"`
+	MinBid    string `json:"min_bid" validate:"required,bigint"`
+	CreatedAt int64  `json:"created_at" validate:"required,gt=0"`
+}
+
+// BidValidationParams is the strict schema for a bid_validation task's
+// EIP-712 payload.
+type BidValidationParams struct {
+	Domain struct {
+		Name              string `json:"name" validate:"required"`
+		Version           string `json:"version" validate:"required"`
+		ChainId           string `json:"chainId" validate:"required,bigint"`
+		VerifyingContract string `json:"verifyingContract" validate:"required,hexaddress"`
+	} `json:"domain" validate:"required"`
+	Bid struct {
+		AuctionId string `json:"auctionId" validate:"required,hexhash"`
+		Bidder    string `json:"bidder" validate:"required,hexaddress"`
+		Amount    string `json:"amount" validate:"required,bigint"`
+		Nonce     string `json:"nonce" validate:"required,bigint"`
+		Deadline  string `json:"deadline" validate:"required,bigint,futureunixts"`
+	} `json:"bid" validate:"required"`
+	Signature string `json:"signature" validate:"required,hexsignature"`
+}
+
+// SettlementParams is the strict schema for a settlement task's commit-reveal
+// parameters.
+type SettlementParams struct {
+	AuctionID   string `json:"auction_id" validate:"required,hexhash"`
+	Commitments []struct {
+		Bidder     string `json:"bidder" validate:"required,hexaddress"`
+		Commitment string `json:"commitment" validate:"required,hexhash"`
+	} `json:"commitments" validate:"required,min=1,dive"`
+	Reveals []struct {
+		Bidder string `json:"bidder" validate:"required,hexaddress"`
+		Amount string `json:"amount" validate:"required,bigint"`
+		Salt   string `json:"salt" validate:"required,hexhash"`
+	} `json:"reveals" validate:"required,min=1,dive"`
+}
+
+// decodeStrict JSON-decodes raw into target, rejecting any field not
+// present in target's schema, then runs struct validation.
+func decodeStrict(raw []byte, target interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(target); err != nil {
+		if strings.Contains(err.Error(), "unknown field") {
+			return fmt.Errorf("%w: %s", ErrUnknownFields, err.Error())
+		}
+		return fmt.Errorf("validation: failed to decode payload: %w", err)
+	}
+
+	if err := v.Struct(target); err != nil {
+		return translateValidationError(err)
+	}
+
+	return nil
+}
+
+// translateValidationError maps the first validator.FieldError into one of
+// this package's typed sentinel errors, so callers can branch on it.
+func translateValidationError(err error) error {
+	fieldErrs, ok := err.(validator.ValidationErrors)
+	if !ok || len(fieldErrs) == 0 {
+		return fmt.Errorf("validation: %w", err)
+	}
+
+	fe := fieldErrs[0]
+	field := fe.Namespace()
+
+	switch fe.Tag() {
+	case "required":
+		return fmt.Errorf("%w: %s", ErrMissingField, field)
+	case "hexaddress":
+		return fmt.Errorf("%w: %s", ErrInvalidAddress, field)
+	case "hexhash":
+		return fmt.Errorf("%w: %s", ErrInvalidHash, field)
+	case "hexsignature":
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, field)
+	case "bigint":
+		return fmt.Errorf("%w: %s", ErrNotNumeric, field)
+	case "futureunixts":
+		return fmt.Errorf("%w: %s", ErrDeadlineInPast, field)
+	case "gt", "lte":
+		if strings.Contains(strings.ToLower(field), "threshold") {
+			return fmt.Errorf("%w: %s", ErrThresholdOutOfRange, field)
+		}
+		if strings.Contains(strings.ToLower(field), "duration") {
+			return fmt.Errorf("%w: %s", ErrDurationOutOfRange, field)
+		}
+		return fmt.Errorf("validation: %s failed %s check", field, fe.Tag())
+	case "min":
+		return fmt.Errorf("%w: %s", ErrEmptyCollection, field)
+	default:
+		return fmt.Errorf("validation: %s failed %s check", field, fe.Tag())
+	}
+}
+
+// ParseShieldMonitoringParams strictly decodes and validates a
+// shield_monitoring task's parameters.
+func ParseShieldMonitoringParams(raw []byte) (*ShieldMonitoringParams, error) {
+	var p ShieldMonitoringParams
+	if err := decodeStrict(raw, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ParseAuctionCreationParams strictly decodes and validates an
+// auction_creation task's parameters.
+func ParseAuctionCreationParams(raw []byte) (*AuctionCreationParams, error) {
+	var p AuctionCreationParams
+	if err := decodeStrict(raw, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ParseBidValidationParams strictly decodes and validates a bid_validation
+// task's EIP-712 parameters.
+func ParseBidValidationParams(raw []byte) (*BidValidationParams, error) {
+	var p BidValidationParams
+	if err := decodeStrict(raw, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// ParseSettlementParams strictly decodes and validates a settlement task's
+// commit-reveal parameters.
+func ParseSettlementParams(raw []byte) (*SettlementParams, error) {
+	var p SettlementParams
+	if err := decodeStrict(raw, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
@@ -0,0 +1,227 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseAuctionCreationParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		wantErr error
+	}{
+		{
+			name:    "valid",
+			payload: `{"auction_id":"0x123","pool_id":"0xabc","duration":3600,"min_bid":"100","created_at":1700000000}`,
+		},
+		{
+			name:    "missing auction_id",
+			payload: `{"pool_id":"0xabc","duration":3600,"min_bid":"100","created_at":1700000000}`,
+			wantErr: ErrMissingField,
+		},
+		{
+			name:    "invalid min_bid",
+			payload: `{"auction_id":"0x123","pool_id":"0xabc","duration":3600,"min_bid":"not-a-number","created_at":1700000000}`,
+			wantErr: ErrNotNumeric,
+		},
+		{
+			name:    "min_bid exceeds uint256",
+			payload: `{"auction_id":"0x123","pool_id":"0xabc","duration":3600,"min_bid":"` + repeat("9", 80) + `","created_at":1700000000}`,
+			wantErr: ErrNotNumeric,
+		},
+		{
+			name:    "duration out of range",
+			payload: `{"auction_id":"0x123","pool_id":"0xabc","duration":999999999,"min_bid":"100","created_at":1700000000}`,
+			wantErr: ErrDurationOutOfRange,
+		},
+		{
+			name:    "missing created_at",
+			payload: `{"auction_id":"0x123","pool_id":"0xabc","duration":3600,"min_bid":"100"}`,
+			wantErr: ErrMissingField,
+		},
+		{
+			name:    "unknown field rejected",
+			payload: `{"auction_id":"0x123","pool_id":"0xabc","duration":3600,"min_bid":"100","created_at":1700000000,"extra":"field"}`,
+			wantErr: ErrUnknownFields,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseAuctionCreationParams([]byte(tc.payload))
+			if tc.wantErr == nil {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("expected error wrapping %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestParseBidValidationParams(t *testing.T) {
+	validBid := `{
+		"domain": {"name":"Shield","version":"1","chainId":"1","verifyingContract":"0x1111111111111111111111111111111111111111"},
+		"bid": {"auctionId":"0x123","bidder":"0x2222222222222222222222222222222222222222","amount":"100","nonce":"1","deadline":"9999999999"},
+		"signature": "0x` + repeat("ab", 65) + `"
+	}`
+
+	tests := []struct {
+		name    string
+		payload string
+		wantErr error
+	}{
+		{name: "valid", payload: validBid},
+		{
+			name: "invalid verifying contract address",
+			payload: `{
+				"domain": {"name":"Shield","version":"1","chainId":"1","verifyingContract":"not-an-address"},
+				"bid": {"auctionId":"0x123","bidder":"0x2222222222222222222222222222222222222222","amount":"100","nonce":"1","deadline":"9999999999"},
+				"signature": "0x` + repeat("ab", 65) + `"
+			}`,
+			wantErr: ErrInvalidAddress,
+		},
+		{
+			name: "deadline in the past",
+			payload: `{
+				"domain": {"name":"Shield","version":"1","chainId":"1","verifyingContract":"0x1111111111111111111111111111111111111111"},
+				"bid": {"auctionId":"0x123","bidder":"0x2222222222222222222222222222222222222222","amount":"100","nonce":"1","deadline":"1"},
+				"signature": "0x` + repeat("ab", 65) + `"
+			}`,
+			wantErr: ErrDeadlineInPast,
+		},
+		{
+			name: "malformed signature length",
+			payload: `{
+				"domain": {"name":"Shield","version":"1","chainId":"1","verifyingContract":"0x1111111111111111111111111111111111111111"},
+				"bid": {"auctionId":"0x123","bidder":"0x2222222222222222222222222222222222222222","amount":"100","nonce":"1","deadline":"9999999999"},
+				"signature": "0xabcd"
+			}`,
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name: "amount exceeds uint256",
+			payload: `{
+				"domain": {"name":"Shield","version":"1","chainId":"1","verifyingContract":"0x1111111111111111111111111111111111111111"},
+				"bid": {"auctionId":"0x123","bidder":"0x2222222222222222222222222222222222222222","amount":"` + repeat("9", 80) + `","nonce":"1","deadline":"9999999999"},
+				"signature": "0x` + repeat("ab", 65) + `"
+			}`,
+			wantErr: ErrNotNumeric,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseBidValidationParams([]byte(tc.payload))
+			if tc.wantErr == nil {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("expected error wrapping %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestParseShieldMonitoringParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		wantErr error
+	}{
+		{
+			name:    "valid",
+			payload: `{"pool_address":"0x1111111111111111111111111111111111111111","chainlink_feed":"0x2222222222222222222222222222222222222222","rpc_endpoints":["https://rpc.example.com"],"threshold":50}`,
+		},
+		{
+			name:    "threshold out of range",
+			payload: `{"pool_address":"0x1111111111111111111111111111111111111111","chainlink_feed":"0x2222222222222222222222222222222222222222","rpc_endpoints":["https://rpc.example.com"],"threshold":20000}`,
+			wantErr: ErrThresholdOutOfRange,
+		},
+		{
+			name:    "no rpc endpoints",
+			payload: `{"pool_address":"0x1111111111111111111111111111111111111111","chainlink_feed":"0x2222222222222222222222222222222222222222","rpc_endpoints":[],"threshold":50}`,
+			wantErr: ErrEmptyCollection,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseShieldMonitoringParams([]byte(tc.payload))
+			if tc.wantErr == nil {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("expected error wrapping %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestParseSettlementParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		wantErr error
+	}{
+		{
+			name: "valid",
+			payload: `{
+				"auction_id": "0x123",
+				"commitments": [{"bidder":"0x1111111111111111111111111111111111111111","commitment":"0xaaaa"}],
+				"reveals": [{"bidder":"0x1111111111111111111111111111111111111111","amount":"100","salt":"0xbbbb"}]
+			}`,
+		},
+		{
+			name: "empty reveals",
+			payload: `{
+				"auction_id": "0x123",
+				"commitments": [{"bidder":"0x1111111111111111111111111111111111111111","commitment":"0xaaaa"}],
+				"reveals": []
+			}`,
+			wantErr: ErrEmptyCollection,
+		},
+		{
+			name: "revealed amount exceeds uint256",
+			payload: `{
+				"auction_id": "0x123",
+				"commitments": [{"bidder":"0x1111111111111111111111111111111111111111","commitment":"0xaaaa"}],
+				"reveals": [{"bidder":"0x1111111111111111111111111111111111111111","amount":"` + repeat("9", 80) + `","salt":"0xbbbb"}]
+			}`,
+			wantErr: ErrNotNumeric,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseSettlementParams([]byte(tc.payload))
+			if tc.wantErr == nil {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("expected error wrapping %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func repeat(s string, n int) string {
+	out := make([]byte, 0, len(s)*n)
+	for i := 0; i < n; i++ {
+		out = append(out, s...)
+	}
+	return string(out)
+}
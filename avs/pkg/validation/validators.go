@@ -0,0 +1,98 @@
+package validation
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	validator "github.com/go-playground/validator/v10"
+)
+
+// maxUint256Bits is the bit width of a Solidity uint256, the upper bound
+// every bigint field in this package's schemas (chainId, amount, nonce,
+// deadline) is implicitly typed as. Values wider than this would overflow
+// the 32-byte words bidsig and auction pad their fields into.
+const maxUint256Bits = 256
+
+// newValidator builds a validator.Validate with the custom tags Shield's
+// task parameter schemas rely on, on top of the library's built-ins.
+func newValidator() *validator.Validate {
+	v := validator.New()
+
+	_ = v.RegisterValidation("hexaddress", validateHexAddress)
+	_ = v.RegisterValidation("hexhash", validateHexHash)
+	_ = v.RegisterValidation("hexsignature", validateHexSignature)
+	_ = v.RegisterValidation("bigint", validateBigInt)
+	_ = v.RegisterValidation("futureunixts", validateFutureUnixTimestamp)
+
+	return v
+}
+
+// validateHexAddress checks that the field is a well-formed 20-byte hex
+// address (e.g. "0xabc...").
+func validateHexAddress(fl validator.FieldLevel) bool {
+	return common.IsHexAddress(fl.Field().String())
+}
+
+// validateHexHash checks that the field decodes to at most 32 bytes of hex,
+// the format this package uses for auction IDs, commitments, and digests.
+func validateHexHash(fl validator.FieldLevel) bool {
+	raw := fl.Field().String()
+	decoded, err := hexutil.Decode(normalizeHex(raw))
+	if err != nil {
+		return false
+	}
+	return len(decoded) <= 32
+}
+
+// validateHexSignature checks that the field is a 65-byte hex-encoded
+// secp256k1 signature (r, s, v).
+func validateHexSignature(fl validator.FieldLevel) bool {
+	decoded, err := hexutil.Decode(fl.Field().String())
+	if err != nil {
+		return false
+	}
+	return len(decoded) == 65
+}
+
+// validateBigInt checks that the field parses as a base-10 or 0x-prefixed,
+// non-negative integer that fits in a uint256 (the format every
+// amount/nonce/deadline/chainId field in this package uses). This bound
+// matters beyond range-checking: values are later left-padded into 32-byte
+// words by abiword.Pad32, which silently truncates anything wider to its
+// low-order 32 bytes.
+func validateBigInt(fl validator.FieldLevel) bool {
+	n, ok := new(big.Int).SetString(fl.Field().String(), 0)
+	if !ok {
+		return false
+	}
+	if n.Sign() < 0 {
+		return false
+	}
+	return n.BitLen() <= maxUint256Bits
+}
+
+// validateFutureUnixTimestamp checks that the field, parsed as a unix
+// second timestamp, is strictly after now.
+func validateFutureUnixTimestamp(fl validator.FieldLevel) bool {
+	seconds, ok := new(big.Int).SetString(fl.Field().String(), 0)
+	if !ok {
+		return false
+	}
+	return time.Unix(seconds.Int64(), 0).After(time.Now())
+}
+
+// normalizeHex ensures s has a 0x prefix and an even number of hex digits,
+// so hexutil.Decode (which rejects both) accepts it. This matches
+// common.HexToHash's leniency: callers elsewhere in this codebase already
+// write auction IDs like "0x123" and expect them to left-pad, not fail.
+func normalizeHex(s string) string {
+	if !(len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X')) {
+		s = "0x" + s
+	}
+	if len(s)%2 != 0 {
+		s = "0x0" + s[2:]
+	}
+	return s
+}